@@ -0,0 +1,803 @@
+package xql
+
+import (
+	"fmt"
+)
+
+// AlterTableStmt changes the definition of a base table.
+//
+//	<alter table statement> ::=
+//		ALTER TABLE <table name> <alter table action>
+//
+// The standard allows a single action per statement, PostgreSQL and MySQL accept a comma-separated list.
+// When the dialect needs several statements to apply all the actions, they are rendered separated by ";\n".
+//
+// https://jakewheat.github.io/sql-overview/sql-2016-foundation-grammar.html#alter-table-statement
+type AlterTableStmt struct {
+	Name    *TableName
+	Actions []AlterTableAction
+}
+
+// AlterTable changes the definition of a table.
+func AlterTable[T ToTableName](name T) *AlterTableStmt {
+	return &AlterTableStmt{Name: newTableName(name)}
+}
+
+type AlterTableAction interface {
+	fmt.Stringer
+
+	Accepter
+
+	alterTableAction() AlterTableAction
+}
+
+var (
+	_ AlterTableAction = &AddColumnAction{}
+	_ AlterTableAction = &DropColumnAction{}
+	_ AlterTableAction = &AlterColumnAction{}
+	_ AlterTableAction = &AddConstraintAction{}
+	_ AlterTableAction = &DropConstraintAction{}
+	_ AlterTableAction = &ValidateConstraintAction{}
+	_ AlterTableAction = &RenameConstraintAction{}
+	_ AlterTableAction = &RenameTableAction{}
+	_ AlterTableAction = &RenameColumnAction{}
+	_ AlterTableAction = &AddPeriodAction{}
+	_ AlterTableAction = &DropPeriodAction{}
+	_ AlterTableAction = &SystemVersioningAction{}
+	_ AlterTableAction = &AttachPartitionAction{}
+	_ AlterTableAction = &DetachPartitionAction{}
+	_ AlterTableAction = &AddPartitionAction{}
+	_ AlterTableAction = &DropPartitionAction{}
+)
+
+func (s *AlterTableStmt) action(x AlterTableAction) *AlterTableStmt {
+	s.Actions = append(s.Actions, x)
+	return s
+}
+
+// AddColumn adds a new column to the table.
+func (s *AlterTableStmt) AddColumn(c *ColumnDef) *AlterTableStmt {
+	return s.action(&AddColumnAction{c})
+}
+
+// DropColumn drops a column from the table.
+func (s *AlterTableStmt) DropColumn(name ColumnName, x ...DropBehavior) *AlterTableStmt {
+	return s.action(&DropColumnAction{name, dropBehavior(x)})
+}
+
+// AlterColumn changes the definition of a column.
+func (s *AlterTableStmt) AlterColumn(name ColumnName) *AlterColumnStep {
+	return &AlterColumnStep{s, name}
+}
+
+// AddConstraint adds a new constraint to the table.
+func (s *AlterTableStmt) AddConstraint(c ToTableConstraintDef) *AlterTableStmt {
+	return s.action(&AddConstraintAction{Constraint: c.tableConstraintDef()})
+}
+
+// AddConstraintNotValid adds a new constraint to the table without checking the existing rows.
+func (s *AlterTableStmt) AddConstraintNotValid(c ToTableConstraintDef) *AlterTableStmt {
+	return s.action(&AddConstraintAction{c.tableConstraintDef(), true})
+}
+
+// ValidateConstraint checks the existing rows against a constraint added without checking them.
+func (s *AlterTableStmt) ValidateConstraint(name string) *AlterTableStmt {
+	return s.action(&ValidateConstraintAction{constraintName(name)})
+}
+
+// DropConstraint drops a constraint of the table.
+func (s *AlterTableStmt) DropConstraint(name string, x ...DropBehavior) *AlterTableStmt {
+	return s.action(&DropConstraintAction{constraintName(name), dropBehavior(x)})
+}
+
+// RenameConstraint renames a constraint of the table.
+func (s *AlterTableStmt) RenameConstraint(name, to string) *AlterTableStmt {
+	return s.action(&RenameConstraintAction{constraintName(name), to})
+}
+
+// RenameTo renames the table.
+func (s *AlterTableStmt) RenameTo(name string) *AlterTableStmt {
+	return s.action(&RenameTableAction{name})
+}
+
+// RenameColumn renames a column of the table.
+func (s *AlterTableStmt) RenameColumn(name, to ColumnName) *AlterTableStmt {
+	return s.action(&RenameColumnAction{name, to})
+}
+
+// AddPeriod adds a period definition to the table.
+func (s *AlterTableStmt) AddPeriod(p *TablePeriodDef) *AlterTableStmt {
+	return s.action(&AddPeriodAction{p})
+}
+
+// DropPeriodForSystemTime drops the system-time period of the table.
+func (s *AlterTableStmt) DropPeriodForSystemTime() *AlterTableStmt {
+	return s.action(&DropPeriodAction{Period: &SystemTimePeriodSpec{}})
+}
+
+// DropPeriod drops an application-time period of the table.
+func (s *AlterTableStmt) DropPeriod(name string) *AlterTableStmt {
+	return s.action(&DropPeriodAction{Period: &ApplicationTimePeriodSpec{name}})
+}
+
+// AddSystemVersioning turns the table into a system-versioned table.
+func (s *AlterTableStmt) AddSystemVersioning() *AlterTableStmt {
+	return s.action(&SystemVersioningAction{})
+}
+
+// DropSystemVersioning turns the table back into a regular table.
+func (s *AlterTableStmt) DropSystemVersioning() *AlterTableStmt {
+	return s.action(&SystemVersioningAction{Drop: true})
+}
+
+func dropBehavior(x []DropBehavior) *DropBehavior {
+	if len(x) == 0 {
+		return nil
+	}
+
+	b := x[len(x)-1]
+	return &b
+}
+
+// Stmts splits the statement into the statements needed by the dialect to apply all the actions.
+//
+// PostgreSQL and MySQL apply a list of actions in a single statement, except that PostgreSQL renames one thing at a time
+// and validates a constraint after it is added, and both change the partitions of the table in a statement of its own.
+// The other dialects apply one action per statement.
+func (s *AlterTableStmt) Stmts(d Dialect) []*AlterTableStmt {
+	if len(s.Actions) < 2 {
+		return []*AlterTableStmt{s}
+	}
+
+	grouped := func(a AlterTableAction) bool {
+		switch d {
+		case PostgreSQL:
+			_, validate := a.(*ValidateConstraintAction)
+			return !isRename(a) && !isPartitioning(a) && !validate
+		case MySQL:
+			return !isPartitioning(a)
+		default:
+			return false
+		}
+	}
+
+	var stmts []*AlterTableStmt
+	var last *AlterTableStmt
+
+	for _, a := range s.Actions {
+		if grouped(a) && last != nil {
+			last.Actions = append(last.Actions, a)
+			continue
+		}
+
+		stmt := &AlterTableStmt{Name: s.Name, Actions: []AlterTableAction{a}}
+		stmts = append(stmts, stmt)
+
+		if grouped(a) {
+			last = stmt
+		}
+	}
+
+	return stmts
+}
+
+func isRename(a AlterTableAction) bool {
+	switch a.(type) {
+	case *RenameTableAction, *RenameColumnAction, *RenameConstraintAction:
+		return true
+	default:
+		return false
+	}
+}
+
+const (
+	kAlterTable = Keyword("ALTER TABLE")
+	kExec       = Keyword("EXEC")
+	kSpRename   = Keyword("sp_rename")
+)
+
+func (s *AlterTableStmt) Accept(v Visitor) Visitor {
+	for i, stmt := range s.Stmts(v.Dialect()) {
+		v = v.If(i > 0, Raw(";\n")).Visit(AcceptFunc(stmt.accept))
+	}
+
+	return v
+}
+
+func (s *AlterTableStmt) accept(v Visitor) Visitor {
+	if v.Dialect() == SQLServer && len(s.Actions) == 1 && isRename(s.Actions[0]) {
+		return s.rename(v, s.Actions[0])
+	}
+
+	return v.Visit(kAlterTable, WS, s.Name).IfNotNil(s.Actions, WS, Joins(s.Actions, Sep))
+}
+
+// rename renders a rename action as a call of the sp_rename procedure on SQL Server.
+func (s *AlterTableStmt) rename(v Visitor, a AlterTableAction) Visitor {
+	v.Visit(kExec, WS, kSpRename, WS)
+
+	switch a := a.(type) {
+	case *RenameTableAction:
+		return v.Raw(quoteLiteral(s.Name.String())).Visit(Sep).Raw(quoteLiteral(a.To))
+	case *RenameColumnAction:
+		return v.Raw(quoteLiteral(s.Name.String() + "." + a.Name)).Visit(Sep).Raw(quoteLiteral(a.To)).
+			Visit(Sep).Raw(quoteLiteral("COLUMN"))
+	case *RenameConstraintAction:
+		return v.Raw(quoteLiteral(a.Name.String())).Visit(Sep).Raw(quoteLiteral(a.To)).
+			Visit(Sep).Raw(quoteLiteral("OBJECT"))
+	default:
+		panic("unreachable")
+	}
+}
+
+func (s *AlterTableStmt) String() string { return XQL(s) }
+
+// AddColumnAction adds a column to the table.
+//
+//	<add column definition> ::= ADD [ COLUMN ] <column definition>
+type AddColumnAction struct {
+	Column *ColumnDef
+}
+
+const (
+	kAdd       = Keyword("ADD")
+	kAddColumn = Keyword("ADD COLUMN")
+)
+
+func (a *AddColumnAction) alterTableAction() AlterTableAction { return a }
+func (a *AddColumnAction) Accept(v Visitor) Visitor {
+	switch v.Dialect() {
+	case SQLServer:
+		return v.Visit(kAdd, WS, a.Column)
+	case Oracle:
+		return v.Visit(kAdd, WS, Paren(a.Column))
+	default:
+		return v.Visit(kAddColumn, WS, a.Column)
+	}
+}
+func (a *AddColumnAction) String() string { return XQL(a) }
+
+// DropColumnAction drops a column of the table.
+//
+//	<drop column definition> ::= DROP [ COLUMN ] <column name> <drop behavior>
+type DropColumnAction struct {
+	Name ColumnName
+	Drop *DropBehavior
+}
+
+const (
+	kDropColumn         = Keyword("DROP COLUMN")
+	kCascadeConstraints = Keyword("CASCADE CONSTRAINTS")
+)
+
+func (a *DropColumnAction) alterTableAction() AlterTableAction { return a }
+func (a *DropColumnAction) Accept(v Visitor) Visitor {
+	v.Visit(kDropColumn, WS, Ident(Raw(a.Name)))
+
+	if a.Drop != nil && v.Dialect() == Oracle && *a.Drop == DropCascade {
+		return v.Visit(WS, kCascadeConstraints)
+	}
+
+	return acceptDropBehavior(v, "DROP COLUMN", a.Drop, PostgreSQL)
+}
+func (a *DropColumnAction) String() string { return XQL(a) }
+
+// acceptDropBehavior renders the drop behavior, it fails unless the dialect supports it.
+func acceptDropBehavior(v Visitor, what string, b *DropBehavior, x ...Dialect) Visitor {
+	if b == nil {
+		return v
+	}
+
+	only(v, what+" "+b.String(), x...)
+
+	return v.Visit(WS, Stringer(b))
+}
+
+// AlterColumnAction changes the definition of a column.
+//
+//	<alter column definition> ::= ALTER [ COLUMN ] <column name> <alter column action>
+type AlterColumnAction struct {
+	Name   ColumnName
+	Action ColumnAlteration
+}
+
+// ColumnAlteration is an action of the ALTER COLUMN clause.
+type ColumnAlteration interface {
+	alterColumn(v Visitor, name ColumnName) Visitor
+}
+
+var (
+	_ ColumnAlteration = &SetColumnDefault{}
+	_ ColumnAlteration = &DropColumnDefault{}
+	_ ColumnAlteration = &SetColumnNotNull{}
+	_ ColumnAlteration = &DropColumnNotNull{}
+	_ ColumnAlteration = &SetColumnDataType{}
+	_ ColumnAlteration = &AddColumnIdentity{}
+	_ ColumnAlteration = &DropColumnIdentity{}
+	_ ColumnAlteration = &SetColumnGenerated{}
+)
+
+const (
+	kAlterColumn  = Keyword("ALTER COLUMN")
+	kModify       = Keyword("MODIFY")
+	kModifyColumn = Keyword("MODIFY COLUMN")
+)
+
+func (a *AlterColumnAction) alterTableAction() AlterTableAction { return a }
+func (a *AlterColumnAction) Accept(v Visitor) Visitor {
+	if v.Dialect() == SQLite {
+		return v.Fail(fmt.Errorf("%w: ALTER COLUMN", ErrUnsupported))
+	}
+
+	return a.Action.alterColumn(v, a.Name)
+}
+func (a *AlterColumnAction) String() string { return XQL(a) }
+
+// alterColumn renders the ALTER COLUMN clause, or the MODIFY clause on Oracle.
+func alterColumn(v Visitor, name ColumnName, x ...Accepter) Visitor {
+	kw := kAlterColumn
+	if v.Dialect() == Oracle {
+		kw = kModify
+	}
+
+	return v.Visit(kw, append([]Accepter{WS, Ident(Raw(name)), WS}, x...)...)
+}
+
+// AlterColumnStep changes the definition of a column.
+type AlterColumnStep struct {
+	*AlterTableStmt
+
+	Name ColumnName
+}
+
+func (s *AlterColumnStep) alter(x ColumnAlteration) *AlterTableStmt {
+	return s.action(&AlterColumnAction{s.Name, x})
+}
+
+// SetDefault sets the default value of the column.
+func (s *AlterColumnStep) SetDefault(x DefaultOption) *AlterTableStmt {
+	return s.alter(&SetColumnDefault{x.AsDefault()})
+}
+
+// DropDefault drops the default value of the column.
+func (s *AlterColumnStep) DropDefault() *AlterTableStmt { return s.alter(&DropColumnDefault{}) }
+
+// SetNotNull marks the column as not accepting null values.
+func (s *AlterColumnStep) SetNotNull() *AlterTableStmt { return s.alter(&SetColumnNotNull{}) }
+
+// DropNotNull marks the column as accepting null values.
+func (s *AlterColumnStep) DropNotNull() *AlterTableStmt { return s.alter(&DropColumnNotNull{}) }
+
+// SetDataType changes the data type of the column.
+func (s *AlterColumnStep) SetDataType(t ToDataType) *AlterColumnTypeStep {
+	c := &SetColumnDataType{Type: t.dataType()}
+
+	return &AlterColumnTypeStep{s.alter(c), c}
+}
+
+// AddIdentity turns the column into an identity column.
+func (s *AlterColumnStep) AddIdentity(x *IdentityColumnSpec) *AlterTableStmt {
+	return s.alter(&AddColumnIdentity{x})
+}
+
+// DropIdentity turns the identity column back into a regular column.
+func (s *AlterColumnStep) DropIdentity() *AlterTableStmt { return s.alter(&DropColumnIdentity{}) }
+
+// DropIdentityIfExists turns the column back into a regular column if it is an identity column.
+func (s *AlterColumnStep) DropIdentityIfExists() *AlterTableStmt {
+	return s.alter(&DropColumnIdentity{IfExists: true})
+}
+
+// SetGenerated changes how the values of the identity column are generated.
+func (s *AlterColumnStep) SetGenerated(x GeneratedAction) *AlterTableStmt {
+	return s.alter(&SetColumnGenerated{x})
+}
+
+// AlterColumnTypeStep changes the data type of a column.
+type AlterColumnTypeStep struct {
+	*AlterTableStmt
+
+	Alteration *SetColumnDataType
+}
+
+// Using computes the new column value from the old one.
+func (s *AlterColumnTypeStep) Using(x ValueExpr) *AlterTableStmt {
+	s.Alteration.Using = x
+	return s.AlterTableStmt
+}
+
+// NotNull restates that the column doesn't accept null values.
+func (s *AlterColumnTypeStep) NotNull() *AlterColumnTypeStep {
+	notNull := true
+	s.Alteration.NotNull = &notNull
+	return s
+}
+
+// Null restates that the column accepts null values.
+func (s *AlterColumnTypeStep) Null() *AlterColumnTypeStep {
+	notNull := false
+	s.Alteration.NotNull = &notNull
+	return s
+}
+
+// SetColumnDefault sets the default value of a column.
+//
+//	<set column default clause> ::= SET <default clause>
+//
+// It is rendered as ADD DEFAULT ... FOR column on SQL Server.
+type SetColumnDefault struct {
+	Default *DefaultClause
+}
+
+func (c *SetColumnDefault) alterColumn(v Visitor, name ColumnName) Visitor {
+	switch v.Dialect() {
+	case SQLServer:
+		return v.Visit(kAdd, WS, c.Default, WS, kFor, WS, Ident(Raw(name)))
+	case Oracle:
+		return alterColumn(v, name, c.Default)
+	default:
+		return alterColumn(v, name, kSet, WS, c.Default)
+	}
+}
+
+// DropColumnDefault drops the default value of a column.
+//
+//	<drop column default clause> ::= DROP DEFAULT
+type DropColumnDefault struct{}
+
+const kDropDefault = Keyword("DROP DEFAULT")
+
+func (c *DropColumnDefault) alterColumn(v Visitor, name ColumnName) Visitor {
+	switch v.Dialect() {
+	case SQLServer:
+		return v.Fail(fmt.Errorf("%w: DROP DEFAULT without the constraint name", ErrUnsupported))
+	case Oracle:
+		return alterColumn(v, name, Null.AsDefault())
+	default:
+		return alterColumn(v, name, kDropDefault)
+	}
+}
+
+// SetColumnNotNull marks a column as not accepting null values.
+//
+//	<set column not null clause> ::= SET NOT NULL
+type SetColumnNotNull struct{}
+
+const kSetNotNull = Keyword("SET NOT NULL")
+
+func (c *SetColumnNotNull) alterColumn(v Visitor, name ColumnName) Visitor {
+	only(v, "SET NOT NULL without the data type", PostgreSQL, Oracle)
+
+	if v.Dialect() == Oracle {
+		return alterColumn(v, name, kNotNull)
+	}
+
+	return alterColumn(v, name, kSetNotNull)
+}
+
+// DropColumnNotNull marks a column as accepting null values.
+//
+//	<drop column not null clause> ::= DROP NOT NULL
+type DropColumnNotNull struct{}
+
+const kDropNotNull = Keyword("DROP NOT NULL")
+
+func (c *DropColumnNotNull) alterColumn(v Visitor, name ColumnName) Visitor {
+	only(v, "DROP NOT NULL without the data type", PostgreSQL, Oracle)
+
+	if v.Dialect() == Oracle {
+		return alterColumn(v, name, kNull)
+	}
+
+	return alterColumn(v, name, kDropNotNull)
+}
+
+// SetColumnDataType changes the data type of a column.
+//
+//	<alter column data type clause> ::= SET DATA TYPE <data type>
+//
+// The USING clause computing the new value is a PostgreSQL extension.
+//
+// SQL Server and MySQL redefine the whole column, which would silently accept null values again,
+// so they require the nullability to be restated. The other dialects keep it and don't render it.
+type SetColumnDataType struct {
+	Type    DataType
+	NotNull *bool
+	Using   ValueExpr
+}
+
+const kSetDataType = Keyword("SET DATA TYPE")
+
+func (c *SetColumnDataType) alterColumn(v Visitor, name ColumnName) Visitor {
+	dataType := AcceptFunc(func(v Visitor) Visitor { return v.DataType(c.Type) })
+
+	if c.NotNull == nil && v.Dialect().Is(MySQL, SQLServer) {
+		v.Fail(fmt.Errorf("%w: changing the data type without NULL or NOT NULL would accept null values", ErrIncomplete))
+	}
+
+	switch v.Dialect() {
+	case MySQL:
+		v.Visit(kModifyColumn, WS, Ident(Raw(name)), WS, dataType)
+	case SQLServer:
+		alterColumn(v, name, dataType)
+	case Oracle:
+		alterColumn(v, name, dataType)
+	default:
+		alterColumn(v, name, kSetDataType, WS, dataType)
+	}
+
+	if c.NotNull != nil && v.Dialect().Is(MySQL, SQLServer) {
+		v.Visit(WS).IfElse(*c.NotNull, kNotNull, kNull)
+	}
+
+	if c.Using != nil {
+		only(v, "SET DATA TYPE ... USING", PostgreSQL)
+
+		v.Visit(WS, kUsing, WS, accept(c.Using))
+	}
+
+	return v
+}
+
+// AddColumnIdentity turns a column into an identity column.
+//
+//	ADD GENERATED { ALWAYS | BY DEFAULT } AS IDENTITY [ ( <sequence options> ) ]
+type AddColumnIdentity struct {
+	Identity *IdentityColumnSpec
+}
+
+func (c *AddColumnIdentity) alterColumn(v Visitor, name ColumnName) Visitor {
+	only(v, "ADD IDENTITY", PostgreSQL, Oracle)
+
+	if v.Dialect() == Oracle {
+		return alterColumn(v, name, c.Identity)
+	}
+
+	return alterColumn(v, name, kAdd, WS, c.Identity)
+}
+
+// DropColumnIdentity turns an identity column back into a regular column.
+//
+//	<drop identity property clause> ::= DROP IDENTITY
+type DropColumnIdentity struct {
+	IfExists bool
+}
+
+const (
+	kDropIdentity = Keyword("DROP IDENTITY")
+	kIfExists     = Keyword("IF EXISTS")
+)
+
+func (c *DropColumnIdentity) alterColumn(v Visitor, name ColumnName) Visitor {
+	only(v, "DROP IDENTITY", PostgreSQL, Oracle)
+
+	if c.IfExists {
+		only(v, "DROP IDENTITY IF EXISTS", PostgreSQL)
+
+		return alterColumn(v, name, kDropIdentity, WS, kIfExists)
+	}
+
+	return alterColumn(v, name, kDropIdentity)
+}
+
+// SetColumnGenerated changes how the values of an identity column are generated.
+//
+//	<set identity column generation clause> ::= SET GENERATED { ALWAYS | BY DEFAULT }
+//
+// It is rendered as MODIFY column GENERATED ... AS IDENTITY on Oracle.
+type SetColumnGenerated struct {
+	Action GeneratedAction
+}
+
+const kSetGenerated = Keyword("SET GENERATED")
+
+func (c *SetColumnGenerated) alterColumn(v Visitor, name ColumnName) Visitor {
+	only(v, "SET GENERATED", PostgreSQL, Oracle)
+
+	if v.Dialect() == Oracle {
+		return alterColumn(v, name, &IdentityColumnSpec{Action: c.Action})
+	}
+
+	return alterColumn(v, name, kSetGenerated, WS, c.Action)
+}
+
+// AddConstraintAction adds a constraint to the table.
+//
+//	<add table constraint definition> ::= ADD <table constraint definition>
+//
+// A constraint added without checking the existing rows is rendered with NOT VALID on PostgreSQL,
+// WITH NOCHECK on SQL Server and ENABLE NOVALIDATE on Oracle.
+type AddConstraintAction struct {
+	Constraint *TableConstraintDef
+	NotValid   bool
+}
+
+const (
+	kNotValid       = Keyword("NOT VALID")
+	kWithNoCheck    = Keyword("WITH NOCHECK")
+	kEnableNoValid  = Keyword("ENABLE NOVALIDATE")
+	kWithCheckCheck = Keyword("WITH CHECK CHECK CONSTRAINT")
+)
+
+func (a *AddConstraintAction) alterTableAction() AlterTableAction { return a }
+func (a *AddConstraintAction) Accept(v Visitor) Visitor {
+	if v.Dialect() == SQLite {
+		return v.Fail(fmt.Errorf("%w: ADD CONSTRAINT", ErrUnsupported))
+	}
+
+	if !a.NotValid {
+		return v.Visit(kAdd, WS, a.Constraint)
+	}
+
+	switch v.Dialect() {
+	case SQLServer:
+		return v.Visit(kWithNoCheck, WS, kAdd, WS, a.Constraint)
+	case Oracle:
+		return v.Visit(kAdd, WS, a.Constraint, WS, kEnableNoValid)
+	default:
+		only(v, "ADD CONSTRAINT ... NOT VALID", PostgreSQL)
+
+		return v.Visit(kAdd, WS, a.Constraint, WS, kNotValid)
+	}
+}
+func (a *AddConstraintAction) String() string { return XQL(a) }
+
+// ValidateConstraintAction checks the existing rows against a constraint added without checking them.
+//
+//	VALIDATE CONSTRAINT <constraint name>
+//
+// It is rendered as WITH CHECK CHECK CONSTRAINT on SQL Server and MODIFY CONSTRAINT ... VALIDATE on Oracle.
+type ValidateConstraintAction struct {
+	Name ConstraintName
+}
+
+const (
+	kValidateConstraint = Keyword("VALIDATE CONSTRAINT")
+	kModifyConstraint   = Keyword("MODIFY CONSTRAINT")
+	kValidate           = Keyword("VALIDATE")
+)
+
+func (a *ValidateConstraintAction) alterTableAction() AlterTableAction { return a }
+func (a *ValidateConstraintAction) Accept(v Visitor) Visitor {
+	switch v.Dialect() {
+	case SQLServer:
+		return v.Visit(kWithCheckCheck, WS, &a.Name)
+	case Oracle:
+		return v.Visit(kModifyConstraint, WS, &a.Name, WS, kValidate)
+	default:
+		only(v, "VALIDATE CONSTRAINT", PostgreSQL)
+
+		return v.Visit(kValidateConstraint, WS, &a.Name)
+	}
+}
+func (a *ValidateConstraintAction) String() string { return XQL(a) }
+
+// DropConstraintAction drops a constraint of the table.
+//
+//	<drop table constraint definition> ::= DROP CONSTRAINT <constraint name> <drop behavior>
+type DropConstraintAction struct {
+	Name ConstraintName
+	Drop *DropBehavior
+}
+
+const kDropConstraint = Keyword("DROP CONSTRAINT")
+
+func (a *DropConstraintAction) alterTableAction() AlterTableAction { return a }
+func (a *DropConstraintAction) Accept(v Visitor) Visitor {
+	if v.Dialect() == SQLite {
+		return v.Fail(fmt.Errorf("%w: DROP CONSTRAINT", ErrUnsupported))
+	}
+
+	return acceptDropBehavior(v.Visit(kDropConstraint, WS, &a.Name), "DROP CONSTRAINT", a.Drop, PostgreSQL, Oracle)
+}
+func (a *DropConstraintAction) String() string { return XQL(a) }
+
+// RenameConstraintAction renames a constraint of the table.
+//
+//	RENAME CONSTRAINT <constraint name> TO <new name>
+type RenameConstraintAction struct {
+	Name ConstraintName
+	To   string
+}
+
+const kRenameConstraint = Keyword("RENAME CONSTRAINT")
+
+func (a *RenameConstraintAction) alterTableAction() AlterTableAction { return a }
+func (a *RenameConstraintAction) Accept(v Visitor) Visitor {
+	only(v, "RENAME CONSTRAINT", PostgreSQL, Oracle)
+
+	return v.Visit(kRenameConstraint, WS, &a.Name, WS, kTo, WS, Ident(Raw(a.To)))
+}
+func (a *RenameConstraintAction) String() string { return XQL(a) }
+
+// RenameTableAction renames the table.
+//
+//	RENAME TO <new name>
+type RenameTableAction struct {
+	To string
+}
+
+const kRenameTo = Keyword("RENAME TO")
+
+func (a *RenameTableAction) alterTableAction() AlterTableAction { return a }
+func (a *RenameTableAction) Accept(v Visitor) Visitor {
+	return v.Visit(kRenameTo, WS, Ident(Raw(a.To)))
+}
+func (a *RenameTableAction) String() string { return XQL(a) }
+
+// RenameColumnAction renames a column of the table.
+//
+//	RENAME COLUMN <column name> TO <new name>
+type RenameColumnAction struct {
+	Name ColumnName
+	To   ColumnName
+}
+
+const kRenameColumn = Keyword("RENAME COLUMN")
+
+func (a *RenameColumnAction) alterTableAction() AlterTableAction { return a }
+func (a *RenameColumnAction) Accept(v Visitor) Visitor {
+	return v.Visit(kRenameColumn, WS, Ident(Raw(a.Name)), WS, kTo, WS, Ident(Raw(a.To)))
+}
+func (a *RenameColumnAction) String() string { return XQL(a) }
+
+// AddPeriodAction adds a period definition to the table.
+//
+//	<add table period definition> ::= ADD <table period definition>
+type AddPeriodAction struct {
+	Period *TablePeriodDef
+}
+
+func (a *AddPeriodAction) alterTableAction() AlterTableAction { return a }
+func (a *AddPeriodAction) Accept(v Visitor) Visitor {
+	only(v, "ADD PERIOD", SQLServer)
+
+	return v.Visit(kAdd, WS, accept(a.Period))
+}
+func (a *AddPeriodAction) String() string { return XQL(a) }
+
+// DropPeriodAction drops a period definition of the table.
+//
+//	<drop table period definition> ::= DROP <system or application time period specification> <drop behavior>
+type DropPeriodAction struct {
+	Period fmt.Stringer
+}
+
+const kDrop = Keyword("DROP")
+
+func (a *DropPeriodAction) alterTableAction() AlterTableAction { return a }
+func (a *DropPeriodAction) Accept(v Visitor) Visitor {
+	only(v, "DROP PERIOD", SQLServer)
+
+	return v.Visit(kDrop, WS, accept(a.Period))
+}
+func (a *DropPeriodAction) String() string { return XQL(a) }
+
+// SystemVersioningAction adds or drops the system versioning of the table.
+//
+//	<add system versioning clause> ::= ADD <system versioning clause>
+//	<drop system versioning clause> ::= DROP SYSTEM VERSIONING <drop behavior>
+//
+// It is rendered as SET (SYSTEM_VERSIONING = ON | OFF) on SQL Server.
+type SystemVersioningAction struct {
+	Drop bool
+}
+
+const (
+	kAddSystemVersioning  = Keyword("ADD SYSTEM VERSIONING")
+	kDropSystemVersioning = Keyword("DROP SYSTEM VERSIONING")
+	kSystemVersioningOn   = Keyword("SET (SYSTEM_VERSIONING = ON)")
+	kSystemVersioningOff  = Keyword("SET (SYSTEM_VERSIONING = OFF)")
+)
+
+func (a *SystemVersioningAction) alterTableAction() AlterTableAction { return a }
+func (a *SystemVersioningAction) Accept(v Visitor) Visitor {
+	only(v, "SYSTEM VERSIONING", SQLServer)
+
+	if v.Dialect() == SQLServer {
+		return v.IfElse(a.Drop, kSystemVersioningOff, kSystemVersioningOn)
+	}
+
+	return v.IfElse(a.Drop, kDropSystemVersioning, kAddSystemVersioning)
+}
+func (a *SystemVersioningAction) String() string { return XQL(a) }
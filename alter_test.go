@@ -0,0 +1,155 @@
+package xql_test
+
+import (
+	"fmt"
+
+	. "github.com/flier/xql"
+)
+
+func ExampleAlterTable() {
+	alter := AlterTable("distributors").
+		AddColumn(Column("address", VarChar(30))).
+		DropColumn("zipcode").
+		AlterColumn("status").SetDefault(Literal("'current'")).
+		AddConstraint(Constraint("dist_id_key").Unique("dist_id")).
+		RenameColumn("address", "city")
+
+	for _, d := range []Dialect{StandardSQL, PostgreSQL, MySQL, SQLServer, Oracle} {
+		fmt.Printf("%s: %s\n", d, d.XQL(alter))
+	}
+	// Output:
+	// SQL: ALTER TABLE distributors ADD COLUMN address VARCHAR(30);
+	// ALTER TABLE distributors DROP COLUMN zipcode;
+	// ALTER TABLE distributors ALTER COLUMN status SET DEFAULT 'current';
+	// ALTER TABLE distributors ADD CONSTRAINT dist_id_key UNIQUE (dist_id);
+	// ALTER TABLE distributors RENAME COLUMN address TO city
+	// PostgreSQL: ALTER TABLE distributors ADD COLUMN address VARCHAR(30), DROP COLUMN zipcode, ALTER COLUMN status SET DEFAULT 'current', ADD CONSTRAINT dist_id_key UNIQUE (dist_id);
+	// ALTER TABLE distributors RENAME COLUMN address TO city
+	// MySQL: ALTER TABLE distributors ADD COLUMN address VARCHAR(30), DROP COLUMN zipcode, ALTER COLUMN status SET DEFAULT 'current', ADD CONSTRAINT dist_id_key UNIQUE (dist_id), RENAME COLUMN address TO city
+	// SQL Server: ALTER TABLE distributors ADD address VARCHAR(30);
+	// ALTER TABLE distributors DROP COLUMN zipcode;
+	// ALTER TABLE distributors ADD DEFAULT 'current' FOR status;
+	// ALTER TABLE distributors ADD CONSTRAINT dist_id_key UNIQUE (dist_id);
+	// EXEC sp_rename 'distributors.address', 'city', 'COLUMN'
+	// Oracle: ALTER TABLE distributors ADD (address VARCHAR(30));
+	// ALTER TABLE distributors DROP COLUMN zipcode;
+	// ALTER TABLE distributors MODIFY status DEFAULT 'current';
+	// ALTER TABLE distributors ADD CONSTRAINT dist_id_key UNIQUE (dist_id);
+	// ALTER TABLE distributors RENAME COLUMN address TO city
+}
+
+func ExampleAlterTableStmt_Stmts() {
+	alter := AlterTable("films").
+		DropConstraint("films_kind_check", DropCascade).
+		RenameConstraint("films_pkey", "films_code_pkey").
+		RenameTo("movies")
+
+	for _, stmt := range alter.Stmts(SQLServer) {
+		s, err := SQLServer.Build(stmt)
+		fmt.Println(s, err)
+	}
+
+	fmt.Println(PostgreSQL.XQL(alter))
+	// Output:
+	//  xql: unsupported by the dialect: DROP CONSTRAINT CASCADE
+	// EXEC sp_rename 'films_pkey', 'films_code_pkey', 'OBJECT' <nil>
+	// EXEC sp_rename 'films', 'movies' <nil>
+	// ALTER TABLE films DROP CONSTRAINT films_kind_check CASCADE;
+	// ALTER TABLE films RENAME CONSTRAINT films_pkey TO films_code_pkey;
+	// ALTER TABLE films RENAME TO movies
+}
+
+func ExampleAlterColumnStep() {
+	for _, alter := range []*AlterTableStmt{
+		AlterTable("films").AlterColumn("title").SetNotNull(),
+		AlterTable("films").AlterColumn("title").DropNotNull(),
+		AlterTable("films").AlterColumn("kind").DropDefault(),
+		AlterTable("films").AlterColumn("len").SetDataType(Integer).AlterTableStmt,
+		AlterTable("films").AlterColumn("code").AddIdentity(Generated.Always().AsIdentity(StartWith(100))),
+		AlterTable("films").AlterColumn("code").SetGenerated(GeneratedByDefault),
+		AlterTable("films").AlterColumn("code").DropIdentity(),
+	} {
+		fmt.Println(PostgreSQL.XQL(alter))
+		fmt.Println(Oracle.XQL(alter))
+	}
+
+	_, err := MySQL.Build(AlterTable("films").AlterColumn("title").SetNotNull())
+	fmt.Println(err)
+
+	fmt.Println(PostgreSQL.XQL(AlterTable("films").AlterColumn("len").
+		SetDataType(Integer).Using(Raw("extract(epoch FROM len)"))))
+
+	retype := AlterTable("films").AlterColumn("len").SetDataType(Integer)
+	_, err = SQLServer.Build(retype)
+	fmt.Println(err)
+	fmt.Println(SQLServer.XQL(retype.NotNull()))
+	fmt.Println(MySQL.XQL(retype.Null()))
+	fmt.Println(PostgreSQL.XQL(retype.NotNull()))
+	// Output:
+	// ALTER TABLE films ALTER COLUMN title SET NOT NULL
+	// ALTER TABLE films MODIFY title NOT NULL
+	// ALTER TABLE films ALTER COLUMN title DROP NOT NULL
+	// ALTER TABLE films MODIFY title NULL
+	// ALTER TABLE films ALTER COLUMN kind DROP DEFAULT
+	// ALTER TABLE films MODIFY kind DEFAULT NULL
+	// ALTER TABLE films ALTER COLUMN len SET DATA TYPE INTEGER
+	// ALTER TABLE films MODIFY len INTEGER
+	// ALTER TABLE films ALTER COLUMN code ADD GENERATED ALWAYS AS IDENTITY (START WITH 100)
+	// ALTER TABLE films MODIFY code GENERATED ALWAYS AS IDENTITY (START WITH 100)
+	// ALTER TABLE films ALTER COLUMN code SET GENERATED BY DEFAULT
+	// ALTER TABLE films MODIFY code GENERATED BY DEFAULT AS IDENTITY
+	// ALTER TABLE films ALTER COLUMN code DROP IDENTITY
+	// ALTER TABLE films MODIFY code DROP IDENTITY
+	// xql: unsupported by the dialect: SET NOT NULL without the data type
+	// ALTER TABLE films ALTER COLUMN len SET DATA TYPE INTEGER USING extract(epoch FROM len)
+	// xql: incomplete statement: changing the data type without NULL or NOT NULL would accept null values
+	// ALTER TABLE films ALTER COLUMN len INTEGER NOT NULL
+	// ALTER TABLE films MODIFY COLUMN len INTEGER NULL
+	// ALTER TABLE films ALTER COLUMN len SET DATA TYPE INTEGER
+}
+
+func ExampleAlterTableStmt_AddSystemVersioning() {
+	alter := AlterTable("Department").
+		AddPeriod(PeriodForSystemTime("ValidFrom", "ValidTo")).
+		AddSystemVersioning()
+
+	fmt.Println(alter)
+	fmt.Println(SQLServer.XQL(alter))
+	fmt.Println(SQLServer.XQL(AlterTable("Department").DropSystemVersioning().DropPeriodForSystemTime()))
+
+	_, err := PostgreSQL.Build(alter)
+	fmt.Println(err)
+	// Output:
+	// ALTER TABLE Department ADD PERIOD FOR SYSTEM_TIME (ValidFrom, ValidTo);
+	// ALTER TABLE Department ADD SYSTEM VERSIONING
+	// ALTER TABLE Department ADD PERIOD FOR SYSTEM_TIME (ValidFrom, ValidTo);
+	// ALTER TABLE Department SET (SYSTEM_VERSIONING = ON)
+	// ALTER TABLE Department SET (SYSTEM_VERSIONING = OFF);
+	// ALTER TABLE Department DROP PERIOD FOR SYSTEM_TIME
+	// xql: unsupported by the dialect: ADD PERIOD
+}
+
+func ExampleAlterTableStmt_AddConstraintNotValid() {
+	fk := Constraint("orders_customer_fk").ForeignKey("customer_id").References("customers")
+
+	for _, d := range []Dialect{PostgreSQL, SQLServer, Oracle} {
+		fmt.Println(d.XQL(AlterTable("orders").AddConstraintNotValid(fk).ValidateConstraint("orders_customer_fk")))
+	}
+
+	fmt.Println(PostgreSQL.XQL(AlterDomain("zipcode").
+		AddConstraintNotValid(Constraint("zipchk").Check(Raw("char_length(VALUE) = 5"))).
+		ValidateConstraint("zipchk")))
+
+	_, err := MySQL.Build(AlterTable("orders").AddConstraintNotValid(fk))
+	fmt.Println(err)
+	// Output:
+	// ALTER TABLE orders ADD CONSTRAINT orders_customer_fk FOREIGN KEY (customer_id) REFERENCES customers NOT VALID;
+	// ALTER TABLE orders VALIDATE CONSTRAINT orders_customer_fk
+	// ALTER TABLE orders WITH NOCHECK ADD CONSTRAINT orders_customer_fk FOREIGN KEY (customer_id) REFERENCES customers;
+	// ALTER TABLE orders WITH CHECK CHECK CONSTRAINT orders_customer_fk
+	// ALTER TABLE orders ADD CONSTRAINT orders_customer_fk FOREIGN KEY (customer_id) REFERENCES customers ENABLE NOVALIDATE;
+	// ALTER TABLE orders MODIFY CONSTRAINT orders_customer_fk VALIDATE
+	// ALTER DOMAIN zipcode ADD CONSTRAINT zipchk CHECK (char_length(VALUE) = 5) NOT VALID;
+	// ALTER DOMAIN zipcode VALIDATE CONSTRAINT zipchk
+	// xql: unsupported by the dialect: ADD CONSTRAINT ... NOT VALID
+}
@@ -1,5 +1,7 @@
 package xql
 
+import "reflect"
+
 type ArrayType struct {
 	Type DataType
 	Caps uint
@@ -31,3 +33,131 @@ func (t *ArrayType) Accept(v Visitor) Visitor {
 }
 
 func (t *ArrayType) String() string { return XQL(t) }
+
+// ArrayValue is an array value constructor by enumeration.
+//
+//	<array value constructor by enumeration> ::=
+//		ARRAY <left bracket or trigraph> [ <array element list> ] <right bracket or trigraph>
+type ArrayValue []ValueExpr
+
+// Array constructs an array value from the elements.
+func Array(x ...any) ArrayValue {
+	a := make(ArrayValue, len(x))
+
+	for i, v := range x {
+		a[i] = newTypedRowValueExpr(v)
+	}
+
+	return a
+}
+
+func newArrayValue(v reflect.Value) ArrayValue {
+	a := make(ArrayValue, v.Len())
+
+	for i := range a {
+		a[i] = newTypedRowValueExpr(v.Index(i).Interface())
+	}
+
+	return a
+}
+
+func (a ArrayValue) expr() Expr { return a }
+func (a ArrayValue) Accept(v Visitor) Visitor {
+	return v.Visit(kArray, Bracket(Joins(accepts(a), Sep)))
+}
+func (a ArrayValue) String() string { return XQL(a) }
+
+// ArrayQuery is an array value constructor by query.
+//
+//	<array value constructor by query> ::= ARRAY <table subquery>
+type ArrayQuery struct {
+	Query QueryExprBody
+}
+
+// ArrayOfQuery constructs an array value from the rows of the query.
+func ArrayOfQuery(q QueryExprBody) *ArrayQuery { return &ArrayQuery{q} }
+
+func (a *ArrayQuery) expr() Expr               { return a }
+func (a *ArrayQuery) Accept(v Visitor) Visitor { return v.Visit(kArray, Paren(accept(a.Query))) }
+func (a *ArrayQuery) String() string           { return XQL(a) }
+
+// ArrayElementRef references an element of the array.
+//
+//	<array element reference> ::=
+//		<array value expression> <left bracket or trigraph> <numeric value expression> <right bracket or trigraph>
+type ArrayElementRef struct {
+	Array ValueExpr
+	Index ValueExpr
+}
+
+// Elem returns a reference to the element of the array at the index.
+func Elem(array, index any) *ArrayElementRef {
+	return &ArrayElementRef{newTypedRowValueExpr(array), newTypedRowValueExpr(index)}
+}
+
+func (r *ArrayElementRef) expr() Expr { return r }
+func (r *ArrayElementRef) Accept(v Visitor) Visitor {
+	return v.Visit(accept(r.Array), Bracket(accept(r.Index)))
+}
+func (r *ArrayElementRef) String() string { return XQL(r) }
+
+// ArraySliceRef references a slice of the array between the lower and upper bounds.
+type ArraySliceRef struct {
+	Array ValueExpr
+	Lower ValueExpr
+	Upper ValueExpr
+}
+
+// Slice returns a reference to the elements of the array from lower to upper.
+//
+// A nil bound leaves the slice open on that side.
+func Slice(array, lower, upper any) *ArraySliceRef {
+	r := &ArraySliceRef{Array: newTypedRowValueExpr(array)}
+
+	if lower != nil {
+		r.Lower = newTypedRowValueExpr(lower)
+	}
+	if upper != nil {
+		r.Upper = newTypedRowValueExpr(upper)
+	}
+
+	return r
+}
+
+func (r *ArraySliceRef) expr() Expr { return r }
+func (r *ArraySliceRef) Accept(v Visitor) Visitor {
+	return v.Visit(accept(r.Array), Bracket(AcceptFunc(func(v Visitor) Visitor {
+		return v.IfNotNil(r.Lower, accept(r.Lower)).Token(':').IfNotNil(r.Upper, accept(r.Upper))
+	})))
+}
+func (r *ArraySliceRef) String() string { return XQL(r) }
+
+// Cardinality returns the number of elements in the array.
+func Cardinality(array any) *CallExpr { return Call("CARDINALITY", array) }
+
+// TrimArray removes the last n elements from the array.
+func TrimArray(array, n any) *CallExpr { return Call("TRIM_ARRAY", array, n) }
+
+// ConcatExpr concatenates the operands with the || operator.
+//
+//	<array concatenation> ::= <array value expression 1> <concatenation operator> <array primary>
+type ConcatExpr []ValueExpr
+
+// Concat concatenates arrays or strings.
+func Concat(x ...any) ConcatExpr {
+	e := make(ConcatExpr, len(x))
+
+	for i, v := range x {
+		e[i] = newTypedRowValueExpr(v)
+	}
+
+	return e
+}
+
+const kConcat = Keyword("||")
+
+func (e ConcatExpr) expr() Expr { return e }
+func (e ConcatExpr) Accept(v Visitor) Visitor {
+	return v.Visit(Joins(accepts(e), AcceptFunc(func(v Visitor) Visitor { return v.WS().Visit(kConcat).WS() })))
+}
+func (e ConcatExpr) String() string { return XQL(e) }
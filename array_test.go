@@ -19,3 +19,47 @@ func ExampleArrayType() {
 	// INTEGER ARRAY[100]
 	// INTEGER ARRAY[] ARRAY[]
 }
+
+func ExampleArray() {
+	fmt.Println(Array(1, 2, 3))
+	fmt.Println(Array())
+	fmt.Println(ArrayOfQuery(Select(Column("id")).From(QName("users"))))
+	fmt.Println(InsertInto("posts", Columns("id", "tags").Values(1, []string{"go", "sql"})))
+	fmt.Println(InsertInto("posts", Columns("id", "tags").Values(2, []string(nil))))
+	fmt.Println(InsertInto("posts", Columns("id", "tags").Values(3, []string{})))
+	// Output:
+	// ARRAY[1, 2, 3]
+	// ARRAY[]
+	// ARRAY(SELECT id FROM users)
+	// INSERT INTO posts (id, tags) VALUES (1, ARRAY['go', 'sql'])
+	// INSERT INTO posts (id, tags) VALUES (2, NULL)
+	// INSERT INTO posts (id, tags) VALUES (3, ARRAY[])
+}
+
+func ExampleElem() {
+	tags := Column("tags")
+
+	fmt.Println(Elem(tags, 1))
+	fmt.Println(Slice(tags, 2, 3))
+	fmt.Println(Slice(tags, nil, 3))
+	fmt.Println(Cardinality(tags))
+	fmt.Println(TrimArray(tags, 2))
+	fmt.Println(Concat(tags, Array("new")))
+	// Output:
+	// tags[1]
+	// tags[2:3]
+	// tags[:3]
+	// CARDINALITY(tags)
+	// TRIM_ARRAY(tags, 2)
+	// tags || ARRAY['new']
+}
+
+func ExampleAny() {
+	fmt.Println(Eq(Column("id"), Any([]int{1, 2, 3})))
+	fmt.Println(Eq(Raw("'go'"), Any(Column("tags"))))
+	fmt.Println(Gt(Column("price"), All(Select(Column("price")).From(QName("discounts")))))
+	// Output:
+	// id = ANY(ARRAY[1, 2, 3])
+	// 'go' = ANY(tags)
+	// price > ALL (SELECT price FROM discounts)
+}
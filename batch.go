@@ -0,0 +1,143 @@
+package xql
+
+import "fmt"
+
+// maxParams is the default maximum number of bind parameters of a statement on each database,
+// Oracle documents no limit and gets the conservative 32767 of the common drivers.
+var maxParams = map[Dialect]int{
+	PostgreSQL: 65535,
+	MySQL:      65535,
+	SQLite:     32766,
+	SQLServer:  2100,
+	Oracle:     32767,
+}
+
+// Batch splits the inserted rows into statements of at most n rows.
+//
+// The statements keep the columns, the OVERRIDING clause, the upsert and the RETURNING clause,
+// the statement is returned unchanged when it doesn't insert a list of values.
+func (i *InsertStmt) Batch(n int) []*InsertStmt {
+	f, ok := i.From.(*FromConstructor)
+	if !ok || n <= 0 {
+		return []*InsertStmt{i}
+	}
+
+	rows := f.Values.rows()
+	if len(rows) <= n {
+		return []*InsertStmt{i}
+	}
+
+	var stmts []*InsertStmt
+
+	for len(rows) > 0 {
+		chunk := rows
+		if len(chunk) > n {
+			chunk = chunk[:n]
+		}
+		rows = rows[len(chunk):]
+
+		values := make(ValueConstructor, len(chunk))
+		for j, row := range chunk {
+			values[j] = rowValue(row)
+		}
+
+		s := *i
+		s.From = &FromConstructor{Columns: f.Columns, Overriding: f.Overriding, Values: values}
+		stmts = append(stmts, &s)
+	}
+
+	return stmts
+}
+
+// maxRows is the default maximum number of rows of a table value constructor on each database,
+// the databases which are missing have no such limit.
+var maxRows = map[Dialect]int{
+	SQLServer: 1000,
+}
+
+// BatchOption overrides a default limit of BatchFor.
+type BatchOption interface {
+	applyBatchOptions(*batchOptions)
+}
+
+type batchOptions struct {
+	params int
+	rows   int
+}
+
+type applyBatchOptionsFunc func(*batchOptions)
+
+func (f applyBatchOptionsFunc) applyBatchOptions(o *batchOptions) { f(o) }
+
+// MaxParams overrides the maximum number of bind parameters of a statement,
+// e.g. SQLite before 3.32 is limited to 999 parameters.
+func MaxParams(n int) BatchOption {
+	return applyBatchOptionsFunc(func(o *batchOptions) { o.params = n })
+}
+
+// MaxRows overrides the maximum number of rows of a statement.
+func MaxRows(n int) BatchOption {
+	return applyBatchOptionsFunc(func(o *batchOptions) { o.rows = n })
+}
+
+// BatchParams splits the inserted rows into statements which bind at most limit parameters,
+// every inserted value is counted as a parameter.
+//
+// The values are rendered inline rather than bound, so the limit only estimates the size of the statements,
+// it is exact when the caller replaces the values with placeholders.
+//
+// It fails when a single row binds more than limit parameters.
+func (i *InsertStmt) BatchParams(limit int) ([]*InsertStmt, error) {
+	n, err := i.batchSize(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.Batch(n), nil
+}
+
+// BatchFor splits the inserted rows into statements which don't exceed the limits of the dialect
+// on the bind parameters, as BatchParams counts them, and on the rows of a table value constructor.
+//
+// MaxParams and MaxRows override the defaults of the dialect for this call.
+func (i *InsertStmt) BatchFor(d Dialect, opts ...BatchOption) ([]*InsertStmt, error) {
+	o := &batchOptions{params: maxParams[d], rows: maxRows[d]}
+	for _, opt := range opts {
+		opt.applyBatchOptions(o)
+	}
+
+	n, err := i.batchSize(o.params)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.rows > 0 && (n <= 0 || n > o.rows) {
+		n = o.rows
+	}
+
+	return i.Batch(n), nil
+}
+
+// batchSize returns the number of rows which bind at most limit parameters,
+// or zero when the rows aren't limited.
+func (i *InsertStmt) batchSize(limit int) (int, error) {
+	f, ok := i.From.(*FromConstructor)
+	if !ok || limit <= 0 {
+		return 0, nil
+	}
+
+	width := len(f.Columns)
+	if rows := f.Values.rows(); len(rows) > 0 && len(rows[0]) > width {
+		width = len(rows[0])
+	}
+
+	if width == 0 {
+		return 1, nil
+	}
+
+	if width > limit {
+		return 0, fmt.Errorf("%w: %d values per row exceed the limit of %d", ErrUnsupported, width, limit)
+	}
+
+	return limit / width, nil
+}
@@ -0,0 +1,50 @@
+package xql_test
+
+import (
+	"fmt"
+
+	. "github.com/flier/xql"
+)
+
+func ExampleInsertStmt_Batch() {
+	stmt := InsertInto("products").Columns("product_no", "name").OverridingSystemValue().Values(Rows{
+		{1, "Cheese"},
+		{2, "Bread"},
+		{3, "Milk"},
+	})
+
+	for _, s := range stmt.Batch(2) {
+		fmt.Println(PostgreSQL.XQL(s))
+	}
+
+	stmts, _ := stmt.BatchParams(5)
+	for _, s := range stmts {
+		fmt.Println(PostgreSQL.XQL(s))
+	}
+
+	_, err := stmt.BatchParams(1)
+	fmt.Println(err)
+
+	var rows Rows
+	for i := 0; i < 2500; i++ {
+		rows = append(rows, Row{i, "Item"})
+	}
+
+	stmts, _ = InsertInto("products").Columns("product_no", "name").Values(rows).BatchFor(SQLServer)
+	fmt.Println(len(stmts))
+
+	stmts, _ = InsertInto("products").Columns("product_no", "name").Values(rows).BatchFor(SQLite, MaxParams(999))
+	fmt.Println(len(stmts))
+	// Output:
+	// INSERT INTO products (product_no, name) OVERRIDING SYSTEM VALUE VALUES
+	// 	(1, 'Cheese'),
+	// 	(2, 'Bread')
+	// INSERT INTO products (product_no, name) OVERRIDING SYSTEM VALUE VALUES (3, 'Milk')
+	// INSERT INTO products (product_no, name) OVERRIDING SYSTEM VALUE VALUES
+	// 	(1, 'Cheese'),
+	// 	(2, 'Bread')
+	// INSERT INTO products (product_no, name) OVERRIDING SYSTEM VALUE VALUES (3, 'Milk')
+	// xql: unsupported by the dialect: 2 values per row exceed the limit of 1
+	// 3
+	// 6
+}
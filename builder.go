@@ -19,6 +19,29 @@ func Stringer(s fmt.Stringer) Accepter {
 	})
 }
 
+// accept visits x with its own Accept method when it has one, or writes its string form otherwise.
+func accept(x fmt.Stringer) Accepter {
+	if isNil(x) {
+		return nil
+	}
+
+	if a, ok := x.(Accepter); ok {
+		return a
+	}
+
+	return Stringer(x)
+}
+
+func accepts[T fmt.Stringer](x []T) []Accepter {
+	a := make([]Accepter, len(x))
+
+	for i, v := range x {
+		a[i] = accept(v)
+	}
+
+	return a
+}
+
 var (
 	Bracket = AcceptFactoryFunc(func(x ...Accepter) AcceptFunc {
 		return func(v Visitor) Visitor {
@@ -68,7 +91,7 @@ func Uint(n uint) AcceptFunc {
 	}
 }
 
-func Joins[T Accepter](s []T, sep AcceptFunc) Accepter {
+func Joins[T Accepter](s []T, sep Accepter) Accepter {
 	return AcceptFunc(func(v Visitor) Visitor {
 		for i, a := range s {
 			if i > 0 {
@@ -124,6 +147,12 @@ type Visitor interface {
 	IfElse(cond bool, then Accepter, or Accepter) Visitor
 
 	IfNotNil(cond any, a Accepter, x ...Accepter) Visitor
+
+	Dialect() Dialect
+
+	Fail(err error) Visitor
+
+	Err() error
 }
 
 var _ Visitor = &Builder{}
@@ -133,6 +162,8 @@ type Builder struct {
 	WhiteSpace rune
 	Separator  rune
 	Quote      rune
+	dialect    Dialect
+	err        error
 }
 
 func XQL(a Accepter) string {
@@ -141,6 +172,9 @@ func XQL(a Accepter) string {
 	return b.String()
 }
 
+// Build renders the statement in standard SQL, it fails when the statement is invalid.
+func Build(a Accepter) (string, error) { return StandardSQL.Build(a) }
+
 func NewBuilder() *Builder {
 	return &Builder{
 		WhiteSpace: ' ',
@@ -149,6 +183,27 @@ func NewBuilder() *Builder {
 	}
 }
 
+func NewDialectBuilder(d Dialect) *Builder {
+	return &Builder{
+		WhiteSpace: ' ',
+		Separator:  ',',
+		Quote:      d.quote(),
+		dialect:    d,
+	}
+}
+
+func (b *Builder) Dialect() Dialect { return b.dialect }
+
+// Fail records the first error found while building the statement.
+func (b *Builder) Fail(err error) Visitor {
+	if b.err == nil {
+		b.err = err
+	}
+	return b
+}
+
+func (b *Builder) Err() error { return b.err }
+
 func (b *Builder) WS() Visitor {
 	b.WriteRune(b.WhiteSpace)
 	return b
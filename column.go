@@ -270,6 +270,8 @@ func (d *ColumnConstraintDef) applyColumnDef(c *ColumnDef) {
 }
 
 func (d *ColumnConstraintDef) Accept(v Visitor) Visitor {
+	acceptEnforcement(v, d.Constraint, d.Characteristics)
+
 	return v.Visit(d.Name, WS).Visit(d.Constraint).Visit(WS, d.Characteristics)
 }
 
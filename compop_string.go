@@ -0,0 +1,28 @@
+// Code generated by "stringer -type=CompOp -linecomment"; DO NOT EDIT.
+
+package xql
+
+import "strconv"
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[CompEq-0]
+	_ = x[CompNe-1]
+	_ = x[CompLt-2]
+	_ = x[CompGt-3]
+	_ = x[CompLe-4]
+	_ = x[CompGe-5]
+}
+
+const _CompOp_name = "=<><><=>="
+
+var _CompOp_index = [...]uint8{0, 1, 3, 4, 5, 7, 9}
+
+func (i CompOp) String() string {
+	if i < 0 || i >= CompOp(len(_CompOp_index)-1) {
+		return "CompOp(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _CompOp_name[_CompOp_index[i]:_CompOp_index[i+1]]
+}
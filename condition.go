@@ -1,3 +1,266 @@
 package xql
 
+import "reflect"
+
 type SearchCond BoolValueExpr
+
+const (
+	kNot = Keyword("NOT")
+	kIs  = Keyword("IS")
+)
+
+//go:generate stringer -type=CompOp -linecomment
+
+type CompOp int
+
+const (
+	CompEq CompOp = iota // =
+	CompNe               // <>
+	CompLt               // <
+	CompGt               // >
+	CompLe               // <=
+	CompGe               // >=
+)
+
+func (op CompOp) Accept(v Visitor) Visitor { return v.Raw(op.String()) }
+
+// ComparisonPredicate compares two values.
+//
+//	<comparison predicate> ::= <row value predicand> <comp op> <row value predicand>
+type ComparisonPredicate struct {
+	Left  ValueExpr
+	Op    CompOp
+	Right ValueExpr
+}
+
+func compare(left any, op CompOp, right any) *ComparisonPredicate {
+	return &ComparisonPredicate{newTypedRowValueExpr(left), op, newTypedRowValueExpr(right)}
+}
+
+func Eq(left, right any) *ComparisonPredicate { return compare(left, CompEq, right) }
+func Ne(left, right any) *ComparisonPredicate { return compare(left, CompNe, right) }
+func Lt(left, right any) *ComparisonPredicate { return compare(left, CompLt, right) }
+func Gt(left, right any) *ComparisonPredicate { return compare(left, CompGt, right) }
+func Le(left, right any) *ComparisonPredicate { return compare(left, CompLe, right) }
+func Ge(left, right any) *ComparisonPredicate { return compare(left, CompGe, right) }
+
+func (p *ComparisonPredicate) expr() Expr                   { return p }
+func (p *ComparisonPredicate) boolValueExpr() BoolValueExpr { return p }
+func (p *ComparisonPredicate) Accept(v Visitor) Visitor {
+	return v.Visit(accept(p.Left), WS, p.Op, WS, accept(p.Right))
+}
+func (p *ComparisonPredicate) String() string { return XQL(p) }
+
+//go:generate stringer -type=Quantifier -linecomment
+
+type Quantifier int
+
+const (
+	QuantifierAll  Quantifier = iota // ALL
+	QuantifierSome                   // SOME
+	QuantifierAny                    // ANY
+)
+
+// QuantifiedExpr is the right hand side of a quantified comparison,
+// it compares the left value with every element of an array or rows of a subquery.
+//
+//	<quantified comparison predicate part 2> ::= <comp op> <quantifier> <table subquery>
+type QuantifiedExpr struct {
+	Quantifier Quantifier
+	Value      ValueExpr
+}
+
+// Any is true if the comparison is true for at least one element.
+func Any(x any) *QuantifiedExpr { return &QuantifiedExpr{QuantifierAny, newTypedRowValueExpr(x)} }
+
+// Some is a synonym of Any.
+func Some(x any) *QuantifiedExpr { return &QuantifiedExpr{QuantifierSome, newTypedRowValueExpr(x)} }
+
+// All is true if the comparison is true for every element.
+func All(x any) *QuantifiedExpr { return &QuantifiedExpr{QuantifierAll, newTypedRowValueExpr(x)} }
+
+func (e *QuantifiedExpr) expr() Expr { return e }
+func (e *QuantifiedExpr) Accept(v Visitor) Visitor {
+	if q, ok := e.Value.(*SubQuery); ok {
+		return v.Keyword(e.Quantifier).Visit(WS, q)
+	}
+
+	return v.Keyword(e.Quantifier).Visit(Paren(accept(e.Value)))
+}
+func (e *QuantifiedExpr) String() string { return XQL(e) }
+
+// InPredicate tests if a value is in a list of values or in the rows of a subquery.
+//
+//	<in predicate> ::= <row value predicand> [ NOT ] IN <in predicate value>
+//
+//	<in predicate value> ::= <table subquery> | <left paren> <in value list> <right paren>
+type InPredicate struct {
+	Value  ValueExpr
+	Not    bool
+	Values []ValueExpr
+}
+
+// In tests if the value is one of x.
+//
+// A single Go slice is expanded into the list of its elements,
+// a single Rows into the list of its rows and a single query is used as a subquery.
+// An empty list is rendered as a constant predicate, false for IN and true for NOT IN,
+// since no dialect accepts an empty IN list.
+func In(value any, x ...any) *InPredicate { return newInPredicate(value, false, x) }
+
+// NotIn tests if the value is none of x.
+func NotIn(value any, x ...any) *InPredicate { return newInPredicate(value, true, x) }
+
+func newInPredicate(value any, not bool, x []any) *InPredicate {
+	p := &InPredicate{Value: newTypedRowValueExpr(value), Not: not}
+
+	if len(x) == 1 {
+		switch v := x[0].(type) {
+		case Row, []byte:
+		case Rows:
+			for _, r := range v {
+				p.Values = append(p.Values, newTypedRowValueExpr(Row(r)))
+			}
+			return p
+		default:
+			if rv := reflect.ValueOf(v); rv.Kind() == reflect.Slice {
+				for i := 0; i < rv.Len(); i++ {
+					p.Values = append(p.Values, newTypedRowValueExpr(rv.Index(i).Interface()))
+				}
+				return p
+			}
+		}
+	}
+
+	for _, v := range x {
+		p.Values = append(p.Values, newTypedRowValueExpr(v))
+	}
+
+	return p
+}
+
+const kIn = Keyword("IN")
+
+func (p *InPredicate) expr() Expr                   { return p }
+func (p *InPredicate) boolValueExpr() BoolValueExpr { return p }
+func (p *InPredicate) Accept(v Visitor) Visitor {
+	if len(p.Values) == 0 {
+		return v.IfElse(p.Not, Raw("1 = 1"), Raw("1 = 0"))
+	}
+
+	v.Visit(accept(p.Value), WS).If(p.Not, kNot, WS).Visit(kIn, WS)
+
+	if len(p.Values) == 1 {
+		if q, ok := p.Values[0].(*SubQuery); ok {
+			return v.Visit(q)
+		}
+	}
+
+	return v.Visit(Paren(Joins(accepts(p.Values), Sep)))
+}
+func (p *InPredicate) String() string { return XQL(p) }
+
+// DistinctPredicate is a null-safe comparison of two values,
+// two nulls are not distinct from each other while a null is distinct from any other value.
+//
+//	<distinct predicate> ::= <row value predicand 3> IS [ NOT ] DISTINCT FROM <row value predicand 4>
+//
+// It is rendered as <=> on MySQL and as IS [NOT] on SQLite,
+// SQL Server and Oracle emulate it with EXISTS (SELECT ... INTERSECT SELECT ...).
+type DistinctPredicate struct {
+	Left  ValueExpr
+	Not   bool
+	Right ValueExpr
+}
+
+// IsDistinctFrom tests if the values are different, treating nulls as comparable values.
+func IsDistinctFrom(left, right any) *DistinctPredicate {
+	return &DistinctPredicate{newTypedRowValueExpr(left), false, newTypedRowValueExpr(right)}
+}
+
+// IsNotDistinctFrom tests if the values are equal, treating nulls as comparable values.
+func IsNotDistinctFrom(left, right any) *DistinctPredicate {
+	return &DistinctPredicate{newTypedRowValueExpr(left), true, newTypedRowValueExpr(right)}
+}
+
+const (
+	kDistinctFrom = Keyword("DISTINCT FROM")
+	kNullSafeEq   = Keyword("<=>")
+	kExists       = Keyword("EXISTS")
+	kIntersect    = Keyword("INTERSECT")
+	kFromDual     = Keyword("FROM DUAL")
+)
+
+func (p *DistinctPredicate) expr() Expr                   { return p }
+func (p *DistinctPredicate) boolValueExpr() BoolValueExpr { return p }
+func (p *DistinctPredicate) Accept(v Visitor) Visitor {
+	switch v.Dialect() {
+	case MySQL:
+		cmp := AcceptFunc(func(v Visitor) Visitor {
+			return v.Visit(accept(p.Left), WS, kNullSafeEq, WS, accept(p.Right))
+		})
+
+		return v.IfElse(p.Not, cmp, AcceptFunc(func(v Visitor) Visitor { return v.Visit(kNot, WS, Paren(cmp)) }))
+
+	case SQLite:
+		return v.Visit(accept(p.Left), WS, kIs).If(!p.Not, WS, kNot).Visit(WS, accept(p.Right))
+
+	case SQLServer, Oracle:
+		return v.If(!p.Not, kNot, WS).Visit(kExists, WS, Paren(
+			selectValue(p.Left), WS, kIntersect, WS, selectValue(p.Right),
+		))
+
+	default:
+		return v.Visit(accept(p.Left), WS, kIs, WS).If(p.Not, kNot, WS).Visit(kDistinctFrom, WS, accept(p.Right))
+	}
+}
+func (p *DistinctPredicate) String() string { return XQL(p) }
+
+// selectValue selects the value, or the elements of a row value, from no table.
+func selectValue(x ValueExpr) AcceptFunc {
+	return func(v Visitor) Visitor {
+		v.Visit(kSelect, WS)
+
+		if r, ok := x.(rowValue); ok {
+			v.Visit(Joins(accepts(r), Sep))
+		} else {
+			v.Visit(accept(x))
+		}
+
+		return v.If(v.Dialect() == Oracle, WS, kFromDual)
+	}
+}
+
+// BoolExpr combines the search conditions with AND or OR.
+//
+//	<boolean value expression> ::= <boolean term> | <boolean value expression> OR <boolean term>
+//	<boolean term> ::= <boolean factor> | <boolean term> AND <boolean factor>
+type BoolExpr struct {
+	Op    Keyword
+	Terms []SearchCond
+}
+
+const (
+	kAnd = Keyword("AND")
+	kOr  = Keyword("OR")
+)
+
+func And(x ...SearchCond) *BoolExpr { return &BoolExpr{kAnd, x} }
+func Or(x ...SearchCond) *BoolExpr  { return &BoolExpr{kOr, x} }
+
+func (e *BoolExpr) expr() Expr                   { return e }
+func (e *BoolExpr) boolValueExpr() BoolValueExpr { return e }
+func (e *BoolExpr) Accept(v Visitor) Visitor {
+	for i, t := range e.Terms {
+		v.If(i > 0, WS, e.Op, WS)
+
+		if b, ok := t.(*BoolExpr); ok && b.Op != e.Op && len(b.Terms) > 1 {
+			v.Visit(Paren(b))
+		} else {
+			v.Visit(accept(t))
+		}
+	}
+
+	return v
+}
+func (e *BoolExpr) String() string { return XQL(e) }
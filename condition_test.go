@@ -0,0 +1,90 @@
+package xql_test
+
+import (
+	"fmt"
+
+	. "github.com/flier/xql"
+)
+
+func ExampleGt() {
+	a, b := Column("a"), Column("b")
+
+	fmt.Println(Gt(Row{a, b}, Row{1, 2}))
+	fmt.Println(Eq(Row{a, b}, Select(Column("x"), Column("y")).From(QName("t"))))
+	fmt.Println(Select(Asterisk).From(QName("events")).
+		Where(Gt(Row{Column("created_at"), Column("id")}, Row{"2023-01-01", 42})).Limit(10))
+	// Output:
+	// (a, b) > (1, 2)
+	// (a, b) = (SELECT x, y FROM t)
+	// SELECT * FROM events WHERE (created_at, id) > ('2023-01-01', 42) LIMIT 10
+}
+
+func ExampleIn() {
+	a, b := Column("a"), Column("b")
+
+	fmt.Println(In(Column("id"), 1, 2, 3))
+	fmt.Println(In(Column("id"), []int{1, 2, 3}))
+	fmt.Println(NotIn(Column("id"), Select(Column("user_id")).From(QName("banned"))))
+	fmt.Println(In(Row{a, b}, Rows{{1, 2}, {3, 4}}))
+	fmt.Println(In(Row{a, b}, Row{1, 2}, Row{3, 4}))
+	fmt.Println(In(Column("id"), []int{}))
+	fmt.Println(NotIn(Column("id"), []int(nil)))
+	fmt.Println(Select(Asterisk).From(QName("users")).Where(In(Column("id"))))
+	// Output:
+	// id IN (1, 2, 3)
+	// id IN (1, 2, 3)
+	// id NOT IN (SELECT user_id FROM banned)
+	// (a, b) IN ((1, 2), (3, 4))
+	// (a, b) IN ((1, 2), (3, 4))
+	// 1 = 0
+	// 1 = 1
+	// SELECT * FROM users WHERE 1 = 0
+}
+
+func ExampleIsDistinctFrom() {
+	distinct := IsDistinctFrom(Column("a"), Column("b"))
+	notDistinct := IsNotDistinctFrom(Column("a"), Column("b"))
+
+	for _, d := range []Dialect{StandardSQL, PostgreSQL, MySQL, SQLite, SQLServer, Oracle} {
+		fmt.Printf("%s: %s\n", d, d.XQL(distinct))
+		fmt.Printf("%s: %s\n", d, d.XQL(notDistinct))
+	}
+	// Output:
+	// SQL: a IS DISTINCT FROM b
+	// SQL: a IS NOT DISTINCT FROM b
+	// PostgreSQL: a IS DISTINCT FROM b
+	// PostgreSQL: a IS NOT DISTINCT FROM b
+	// MySQL: NOT (a <=> b)
+	// MySQL: a <=> b
+	// SQLite: a IS NOT b
+	// SQLite: a IS b
+	// SQL Server: NOT EXISTS (SELECT a INTERSECT SELECT b)
+	// SQL Server: EXISTS (SELECT a INTERSECT SELECT b)
+	// Oracle: NOT EXISTS (SELECT a FROM DUAL INTERSECT SELECT b FROM DUAL)
+	// Oracle: EXISTS (SELECT a FROM DUAL INTERSECT SELECT b FROM DUAL)
+}
+
+func ExampleIsNotDistinctFrom() {
+	merge := MergeInto("customers").As("c").
+		Using(QName("staging").As("s")).
+		On(IsNotDistinctFrom(Raw("c.email"), Raw("s.email")))
+
+	_, err := MySQL.Build(merge)
+	fmt.Println(err)
+	fmt.Println(SQLServer.XQL(merge))
+
+	join := &QualifiedJoin{
+		Left:  Left[TableRef, *PartitionedJoinedTable](QName("a")),
+		Type:  JoinLeft,
+		Right: Left[TableRef, *PartitionedJoinedTable](QName("b")),
+		Spec:  JoinSpec{On: &JoinCond{IsNotDistinctFrom(Raw("a.k"), Raw("b.k"))}},
+	}
+
+	fmt.Println(PostgreSQL.XQL(Select(Asterisk).From(join).Where(IsDistinctFrom(Raw("a.v"), Raw("b.v")))))
+	fmt.Println(MySQL.XQL(Update("t").Set(Assign("flag", 1)).Where(IsDistinctFrom(Column("a"), nil))))
+	// Output:
+	// xql: unsupported by the dialect: MERGE
+	// MERGE INTO customers AS c USING staging AS s ON EXISTS (SELECT c.email INTERSECT SELECT s.email);
+	// SELECT * FROM a LEFT JOIN b ON a.k IS NOT DISTINCT FROM b.k WHERE a.v IS DISTINCT FROM b.v
+	// UPDATE t SET flag = 1 WHERE NOT (a <=> NULL)
+}
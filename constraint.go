@@ -47,6 +47,10 @@ func (d *ConstraintNameDef) Unique(x ...ColumnName) *GenericConstraintDef {
 	return &GenericConstraintDef{Name: d, Constraint: &UniqueConstraintDef{Spec: SpecUnique, Columns: x}}
 }
 
+func (d *ConstraintNameDef) Check(cond SearchCond) *GenericConstraintDef {
+	return &GenericConstraintDef{Name: d, Constraint: Check(cond)}
+}
+
 type GenericConstraint interface {
 	fmt.Stringer
 
@@ -55,17 +59,38 @@ type GenericConstraint interface {
 }
 
 type GenericConstraintDef struct {
-	Name       *ConstraintNameDef
-	Constraint GenericConstraint
+	Name            *ConstraintNameDef
+	Constraint      GenericConstraint
+	Characteristics *ConstraintCharacteristics
+}
+
+// NotEnforced keeps the constraint in the schema without checking it.
+func (d *GenericConstraintDef) NotEnforced() *GenericConstraintDef {
+	d.Characteristics = enforce(d.Characteristics, false)
+	return d
+}
+
+// Enforced checks the constraint, it is the default.
+func (d *GenericConstraintDef) Enforced() *GenericConstraintDef {
+	d.Characteristics = enforce(d.Characteristics, true)
+	return d
 }
 
 func (d *GenericConstraintDef) applyColumnDef(c *ColumnDef) {
-	c.Constraints = append(c.Constraints, &ColumnConstraintDef{Name: d.Name, Constraint: d.Constraint})
+	c.Constraints = append(c.Constraints, &ColumnConstraintDef{
+		Name:            d.Name,
+		Constraint:      d.Constraint,
+		Characteristics: d.Characteristics,
+	})
+}
+
+func (d *GenericConstraintDef) tableConstraintDef() *TableConstraintDef {
+	return &TableConstraintDef{Name: d.Name, Constraint: d.Constraint, Characteristics: d.Characteristics}
 }
 
 func (d *GenericConstraintDef) applyTableDef(t *TableDef) {
 	l, _ := t.Content.(TableElementList)
-	t.Content = TableElementList(append(l, &TableConstraintDef{Name: d.Name, Constraint: d.Constraint}))
+	t.Content = TableElementList(append(l, d.tableConstraintDef()))
 }
 
 //go:generate stringer -type=ConstraintCheckTime -linecomment
@@ -88,11 +113,31 @@ type ConstraintCharacteristics struct {
 }
 
 func (c *ConstraintCharacteristics) Accept(v Visitor) Visitor {
-	return v.Visit(c.CheckTime, WS).Visit(c.Deferrable, WS).Visit(c.Enforcement)
+	if c.Deferrable != nil || c.CheckTime != nil {
+		only(v, "DEFERRABLE", PostgreSQL, SQLite, Oracle)
+	}
+	if c.Enforcement != nil {
+		only(v, c.Enforcement.String(), PostgreSQL, MySQL)
+	}
+
+	return v.IfNotNil(c.Deferrable, c.Deferrable).
+		If(c.Deferrable != nil && c.CheckTime != nil, WS).
+		IfNotNil(c.CheckTime, c.CheckTime).
+		If((c.Deferrable != nil || c.CheckTime != nil) && c.Enforcement != nil, WS).
+		IfNotNil(c.Enforcement, c.Enforcement)
 }
 
 func (c *ConstraintCharacteristics) String() string { return XQL(c) }
 
+// acceptEnforcement fails on MySQL when a constraint other than CHECK is [NOT] ENFORCED.
+func acceptEnforcement(v Visitor, constraint any, c *ConstraintCharacteristics) Visitor {
+	if _, check := constraint.(*CheckConstraintDef); c == nil || c.Enforcement == nil || check || v.Dialect() != MySQL {
+		return v
+	}
+
+	return v.Fail(fmt.Errorf("%w: %s on a constraint other than CHECK", ErrUnsupported, c.Enforcement))
+}
+
 type ConstraintDeferrable bool
 
 const (
@@ -127,6 +172,18 @@ func (c *ConstraintEnforcement) Accept(v Visitor) Visitor {
 
 func (c *ConstraintEnforcement) String() string { return XQL(c) }
 
+// enforce sets the enforcement of the characteristics, which are created when they are nil.
+func enforce(c *ConstraintCharacteristics, enforced bool) *ConstraintCharacteristics {
+	if c == nil {
+		c = &ConstraintCharacteristics{}
+	}
+
+	e := ConstraintEnforcement(enforced)
+	c.Enforcement = &e
+
+	return c
+}
+
 type NotNullConstraint struct{}
 
 var NotNull = &NotNullConstraint{}
@@ -180,6 +237,9 @@ func uniqueSpec(spec UniqueSpec) CreateUniqueConstraintDefFunc {
 }
 
 func (d *UniqueConstraintDef) tableConstraint() TableConstraint { return d }
+func (d *UniqueConstraintDef) tableConstraintDef() *TableConstraintDef {
+	return &TableConstraintDef{Constraint: d}
+}
 func (d *UniqueConstraintDef) applyTypedTableDef(t *TableDef) {
 	c := t.Content.(*TypedTableClause)
 	c.Elements = append(c.Elements, &TableConstraintDef{Constraint: d})
@@ -191,26 +251,174 @@ func (d *UniqueConstraintDef) Accept(v Visitor) Visitor {
 }
 func (d *UniqueConstraintDef) String() string { return XQL(d) }
 
+// ReferentialConstraintDef defines a foreign key, the referencing columns match the referenced columns of another table.
+//
+//	<referential constraint definition> ::=
+//		FOREIGN KEY <left paren> <referencing column list> <right paren> <references specification>
+//
+// It is a table constraint, or a column constraint rendered as REFERENCES when it is applied to a column.
+// The number of referencing and referenced columns is checked when the statement is built.
+//
+// https://jakewheat.github.io/sql-overview/sql-2016-foundation-grammar.html#referential-constraint-definition
 type ReferentialConstraintDef struct {
-	Columns ColumnNameList
-	Spec    ReferencesSpec
+	Name            *ConstraintNameDef
+	Columns         ColumnNameList
+	Spec            ReferencesSpec
+	Characteristics *ConstraintCharacteristics
+}
+
+// ForeignKey defines a referential constraint on the referencing columns, which are left out for a column constraint.
+func ForeignKey(x ...ColumnName) *ReferentialConstraintDef {
+	return &ReferentialConstraintDef{Columns: x}
+}
+
+// ForeignKey defines a named referential constraint on the referencing columns.
+func (d *ConstraintNameDef) ForeignKey(x ...ColumnName) *ReferentialConstraintDef {
+	return &ReferentialConstraintDef{Name: d, Columns: x}
+}
+
+// References sets the referenced table and columns, the primary key of the table is referenced without columns.
+func (d *ReferentialConstraintDef) References(table string, x ...ColumnName) *ReferentialConstraintDef {
+	d.Spec.Name = *newTableName(table)
+	d.Spec.Columns = x
+	return d
+}
+
+func (d *ReferentialConstraintDef) action() *ReferentialTriggeredAction {
+	if d.Spec.Action == nil {
+		d.Spec.Action = &ReferentialTriggeredAction{}
+	}
+
+	return d.Spec.Action
+}
+
+// OnDelete sets the action taken when a referenced row is deleted.
+func (d *ReferentialConstraintDef) OnDelete(a ReferentialAction) *ReferentialConstraintDef {
+	d.action().OnDelete = a
+	return d
+}
+
+// OnUpdate sets the action taken when a referenced key is updated.
+func (d *ReferentialConstraintDef) OnUpdate(a ReferentialAction) *ReferentialConstraintDef {
+	d.action().OnUpdate = a
+	return d
+}
+
+// Match sets how the null values of the referencing columns are matched.
+func (d *ReferentialConstraintDef) Match(m MatchType) *ReferentialConstraintDef {
+	d.Spec.Match = m
+	return d
+}
+
+func (d *ReferentialConstraintDef) characteristics() *ConstraintCharacteristics {
+	if d.Characteristics == nil {
+		d.Characteristics = &ConstraintCharacteristics{}
+	}
+
+	return d.Characteristics
+}
+
+// Deferrable allows the constraint to be checked at the end of the transaction.
+func (d *ReferentialConstraintDef) Deferrable() *ReferentialConstraintDef {
+	deferrable := ConstraintDeferrable(true)
+	d.characteristics().Deferrable = &deferrable
+	return d
+}
+
+// NotDeferrable checks the constraint after each statement.
+func (d *ReferentialConstraintDef) NotDeferrable() *ReferentialConstraintDef {
+	deferrable := ConstraintDeferrable(false)
+	d.characteristics().Deferrable = &deferrable
+	return d
+}
+
+// InitiallyDeferred checks the constraint at the end of the transaction, unless the transaction sets otherwise.
+func (d *ReferentialConstraintDef) InitiallyDeferred() *ReferentialConstraintDef {
+	t := CheckTimeInitiallyDeferred
+	d.characteristics().CheckTime = &t
+	return d
+}
+
+// InitiallyImmediate checks the constraint after each statement, unless the transaction sets otherwise.
+func (d *ReferentialConstraintDef) InitiallyImmediate() *ReferentialConstraintDef {
+	t := CheckTimeInitiallyImmediate
+	d.characteristics().CheckTime = &t
+	return d
+}
+
+// NotEnforced keeps the constraint in the schema without checking it.
+func (d *ReferentialConstraintDef) NotEnforced() *ReferentialConstraintDef {
+	d.Characteristics = enforce(d.Characteristics, false)
+	return d
+}
+
+// Enforced checks the constraint, it is the default.
+func (d *ReferentialConstraintDef) Enforced() *ReferentialConstraintDef {
+	d.Characteristics = enforce(d.Characteristics, true)
+	return d
 }
 
 func (d *ReferentialConstraintDef) tableConstraint() TableConstraint { return d }
+func (d *ReferentialConstraintDef) tableConstraintDef() *TableConstraintDef {
+	return &TableConstraintDef{Constraint: d}
+}
+
+func (d *ReferentialConstraintDef) applyTableDef(t *TableDef) {
+	l, _ := t.Content.(TableElementList)
+	t.Content = TableElementList(append(l, d.tableConstraintDef()))
+}
 
 func (d *ReferentialConstraintDef) applyTypedTableDef(t *TableDef) {
 	c := t.Content.(*TypedTableClause)
 	c.Elements = append(c.Elements, &TableConstraintDef{Constraint: d})
 }
 
+func (d *ReferentialConstraintDef) applyColumnDef(c *ColumnDef) {
+	c.Constraints = append(c.Constraints, &ColumnConstraintDef{
+		Name:            d.Name,
+		Constraint:      &columnReferences{&d.Spec, len(d.Columns)},
+		Characteristics: d.Characteristics,
+	})
+}
+
 const kForeignKey = Keyword("FOREIGN KEY")
 
 func (d *ReferentialConstraintDef) Accept(v Visitor) Visitor {
-	return v.Visit(kForeignKey, WS, d.Columns, WS, &d.Spec)
+	if len(d.Columns) == 0 {
+		v.Fail(fmt.Errorf("%w: FOREIGN KEY without columns", ErrColumnCount))
+	} else if d.Spec.Columns != nil && len(d.Spec.Columns) != len(d.Columns) {
+		v.Fail(fmt.Errorf("%w: %d referencing columns, %d referenced", ErrColumnCount, len(d.Columns), len(d.Spec.Columns)))
+	}
+
+	acceptEnforcement(v, d, d.Characteristics)
+
+	return v.IfNotNil(d.Name, d.Name, WS).
+		Visit(kForeignKey, WS, d.Columns, WS, &d.Spec).
+		IfNotNil(d.Characteristics, WS, d.Characteristics)
 }
 
 func (d *ReferentialConstraintDef) String() string { return XQL(d) }
 
+// columnReferences is the referential constraint of a single column.
+//
+// MySQL parses but ignores the REFERENCES of a column, the foreign key has to be a table constraint.
+type columnReferences struct {
+	*ReferencesSpec
+
+	Referencing int
+}
+
+func (c *columnReferences) Accept(v Visitor) Visitor {
+	if c.Referencing > 1 || len(c.Columns) > 1 {
+		v.Fail(fmt.Errorf("%w: REFERENCES on a column with several columns", ErrColumnCount))
+	}
+	if v.Dialect() == MySQL {
+		v.Fail(fmt.Errorf("%w: REFERENCES on a column, use a table FOREIGN KEY", ErrUnsupported))
+	}
+
+	return v.Visit(c.ReferencesSpec)
+}
+
 //go:generate stringer -type=MatchType -linecomment
 
 type MatchType int
@@ -235,8 +443,12 @@ const (
 
 func (s *ReferencesSpec) columnConstraint() ColumnConstraint { return s }
 func (s *ReferencesSpec) Accept(v Visitor) Visitor {
+	if s.Match != MatchSimple {
+		only(v, "MATCH "+s.Match.String(), PostgreSQL, MySQL, SQLite)
+	}
+
 	return v.Visit(kReferences, WS, &s.Name).
-		IfNotNil(s.Columns, WS, Bracket(s.Columns)).
+		IfNotNil(s.Columns, WS, s.Columns).
 		If(s.Match != MatchSimple, WS, kMatch, WS, Keyword(s.Match.String())).
 		IfNotNil(s.Action, WS, s.Action)
 }
@@ -255,14 +467,35 @@ const (
 	Restrict                            // RESTRICT
 )
 
+func (a ReferentialAction) Accept(v Visitor) Visitor {
+	switch {
+	case a == Restrict && v.Dialect().Is(SQLServer, Oracle):
+		v.Fail(fmt.Errorf("%w: %s", ErrUnsupported, a))
+	case a == SetDefault && v.Dialect().Is(MySQL, Oracle):
+		v.Fail(fmt.Errorf("%w: %s", ErrUnsupported, a))
+	}
+
+	return v.Visit(Keyword(a.String()))
+}
+
 type ReferentialTriggeredAction struct {
 	OnUpdate ReferentialAction
 	OnDelete ReferentialAction
 }
 
+const (
+	kOnUpdate = Keyword("ON UPDATE")
+	kOnDelete = Keyword("ON DELETE")
+)
+
 func (r *ReferentialTriggeredAction) Accept(v Visitor) Visitor {
-	return v.If(r.OnUpdate != NoAction, Keyword("ON UPDATE"), WS, Keyword(r.OnUpdate.String())).
-		If(r.OnDelete != NoAction, Keyword("ON DELETE"), WS, Keyword(r.OnDelete.String()))
+	if r.OnUpdate != NoAction && v.Dialect() == Oracle {
+		v.Fail(fmt.Errorf("%w: ON UPDATE", ErrUnsupported))
+	}
+
+	return v.If(r.OnUpdate != NoAction, kOnUpdate, WS, r.OnUpdate).
+		If(r.OnUpdate != NoAction && r.OnDelete != NoAction, WS).
+		If(r.OnDelete != NoAction, kOnDelete, WS, r.OnDelete)
 }
 
 func (r *ReferentialTriggeredAction) String() string { return XQL(r) }
@@ -277,6 +510,11 @@ func Check(cond SearchCond) *CheckConstraintDef {
 
 const kCheck = Keyword("CHECK")
 
+// NotEnforced keeps the check constraint in the schema without checking it.
+func (c *CheckConstraintDef) NotEnforced() *GenericConstraintDef {
+	return (&GenericConstraintDef{Constraint: c}).NotEnforced()
+}
+
 func (c *CheckConstraintDef) columnConstraint() ColumnConstraint { return c }
 func (c *CheckConstraintDef) applyColumnDef(d *ColumnDef) {
 	d.Constraints = append(d.Constraints, &ColumnConstraintDef{Constraint: c})
@@ -286,7 +524,88 @@ func (c *CheckConstraintDef) applyTypedTableDef(t *TableDef) {
 	tc.Elements = append(tc.Elements, &TableConstraintDef{Constraint: c})
 }
 func (c *CheckConstraintDef) tableConstraint() TableConstraint { return c }
+func (c *CheckConstraintDef) tableConstraintDef() *TableConstraintDef {
+	return &TableConstraintDef{Constraint: c}
+}
 func (c *CheckConstraintDef) Accept(v Visitor) Visitor {
 	return v.Visit(kCheck, WS, Paren(Raw(c.Cond.String())))
 }
 func (c *CheckConstraintDef) String() string { return XQL(c) }
+
+// ExclusionConstraintDef guarantees that no two rows compared on the keys with the operators all return true,
+// such as overlapping bookings of the same room.
+//
+//	EXCLUDE [ USING <index method> ] ( <exclude element> WITH <operator> [, ...] ) [ WHERE ( <predicate> ) ]
+//
+// Exclusion constraints are not part of the standard, the syntax follows PostgreSQL.
+type ExclusionConstraintDef struct {
+	Name     *ConstraintNameDef
+	Method   *IndexMethod
+	Elements []*ExclusionElement
+	Cond     SearchCond
+}
+
+// ExclusionElement compares a key of the rows with an operator.
+type ExclusionElement struct {
+	Key      *SortSpec
+	Operator string
+}
+
+// ExcludeUsing defines an exclusion constraint backed by an index of the access method.
+func ExcludeUsing(m IndexMethod) *ExclusionConstraintDef {
+	return &ExclusionConstraintDef{Method: &m}
+}
+
+// ExcludeUsing defines a named exclusion constraint backed by an index of the access method.
+func (d *ConstraintNameDef) ExcludeUsing(m IndexMethod) *ExclusionConstraintDef {
+	return &ExclusionConstraintDef{Name: d, Method: &m}
+}
+
+// With compares the key, a column or an expression, with the operator.
+func (d *ExclusionConstraintDef) With(key ToSortSpec, operator string) *ExclusionConstraintDef {
+	d.Elements = append(d.Elements, &ExclusionElement{key.sortSpec(), operator})
+	return d
+}
+
+// Where only compares the rows that satisfy the predicate.
+func (d *ExclusionConstraintDef) Where(cond SearchCond) *ExclusionConstraintDef {
+	d.Cond = cond
+	return d
+}
+
+func (d *ExclusionConstraintDef) tableConstraint() TableConstraint { return d }
+func (d *ExclusionConstraintDef) tableConstraintDef() *TableConstraintDef {
+	return &TableConstraintDef{Constraint: d}
+}
+
+func (d *ExclusionConstraintDef) applyTableDef(t *TableDef) {
+	l, _ := t.Content.(TableElementList)
+	t.Content = TableElementList(append(l, d.tableConstraintDef()))
+}
+
+func (d *ExclusionConstraintDef) applyTypedTableDef(t *TableDef) {
+	c := t.Content.(*TypedTableClause)
+	c.Elements = append(c.Elements, d.tableConstraintDef())
+}
+
+const kExclude = Keyword("EXCLUDE")
+
+func (d *ExclusionConstraintDef) Accept(v Visitor) Visitor {
+	only(v, "EXCLUDE", PostgreSQL)
+
+	return v.IfNotNil(d.Name, d.Name, WS).
+		Visit(kExclude).
+		IfNotNil(d.Method, WS, kUsing, WS, AcceptFunc(func(v Visitor) Visitor {
+			return v.Visit(Keyword(d.Method.String()))
+		})).
+		Visit(WS, Paren(Joins(d.Elements, Sep))).
+		IfNotNil(d.Cond, WS, kWhere, WS, Paren(accept(d.Cond)))
+}
+
+func (d *ExclusionConstraintDef) String() string { return XQL(d) }
+
+func (e *ExclusionElement) Accept(v Visitor) Visitor {
+	return v.Visit(&indexKey{e.Key}, WS, kWith, WS, Raw(e.Operator))
+}
+
+func (e *ExclusionElement) String() string { return XQL(e) }
@@ -0,0 +1,102 @@
+package xql_test
+
+import (
+	"fmt"
+
+	. "github.com/flier/xql"
+)
+
+func ExampleForeignKey() {
+	orders := CreateTable("orders",
+		Column("id", Integer, PrimaryKey),
+		Column("customer_id", Integer, ForeignKey().References("customers", "id").OnDelete(Cascade)),
+		Column("product_no", Integer),
+		Column("variant_no", Integer),
+		Constraint("orders_product_fk").ForeignKey("product_no", "variant_no").
+			References("products", "no", "variant").
+			OnDelete(Cascade).OnUpdate(SetNull).Match(MatchFull).
+			Deferrable().InitiallyDeferred(),
+	)
+
+	fmt.Println(PostgreSQL.XQL(orders))
+	fmt.Println(PostgreSQL.XQL(AlterTable("orders").AddConstraint(ForeignKey("customer_id").References("customers").OnDelete(Restrict))))
+
+	_, err := PostgreSQL.Build(CreateTable("orders", Column("product_no", Integer),
+		ForeignKey("product_no").References("products", "no", "variant")))
+	fmt.Println(err)
+	_, err = PostgreSQL.Build(CreateTable("orders", Column("product_no", Integer, ForeignKey().References("products", "no", "variant"))))
+	fmt.Println(err)
+	_, err = MySQL.Build(orders)
+	fmt.Println(err)
+	_, err = Oracle.Build(CreateTable("orders", Column("customer_id", Integer, ForeignKey().References("customers").OnUpdate(Cascade))))
+	fmt.Println(err)
+	_, err = MySQL.Build(CreateTable("orders", Column("customer_id", Integer, ForeignKey().References("customers"))))
+	fmt.Println(err)
+	_, err = MySQL.Build(AlterTable("orders").AddConstraint(ForeignKey("customer_id").References("customers").Deferrable()))
+	fmt.Println(err)
+	// Output:
+	// CREATE TABLE orders (
+	// 	id INTEGER PRIMARY KEY,
+	// 	customer_id INTEGER REFERENCES customers (id) ON DELETE CASCADE,
+	// 	product_no INTEGER,
+	// 	variant_no INTEGER,
+	// 	CONSTRAINT orders_product_fk FOREIGN KEY (product_no, variant_no) REFERENCES products (no, variant) MATCH FULL ON UPDATE SET NULL ON DELETE CASCADE DEFERRABLE INITIALLY DEFERRED
+	// )
+	// ALTER TABLE orders ADD FOREIGN KEY (customer_id) REFERENCES customers ON DELETE RESTRICT
+	// xql: column count mismatch: 1 referencing columns, 2 referenced
+	// xql: column count mismatch: REFERENCES on a column with several columns
+	// xql: unsupported by the dialect: REFERENCES on a column, use a table FOREIGN KEY
+	// xql: unsupported by the dialect: ON UPDATE
+	// xql: unsupported by the dialect: REFERENCES on a column, use a table FOREIGN KEY
+	// xql: unsupported by the dialect: DEFERRABLE
+}
+
+func ExampleExcludeUsing() {
+	fmt.Println(PostgreSQL.XQL(CreateTable("bookings",
+		Column("room", Integer),
+		Column("during", SchemaQName("tsrange")),
+		Column("cancelled", Boolean),
+		Constraint("no_overlap").ExcludeUsing(IndexGist).
+			With(Column("room"), "=").
+			With(Column("during"), "&&").
+			Where(Raw("NOT cancelled")),
+	)))
+	fmt.Println(PostgreSQL.XQL(AlterTable("bookings").AddConstraint(ExcludeUsing(IndexGist).With(Raw("tsrange(starts, ends)"), "&&"))))
+
+	_, err := MySQL.Build(CreateTable("bookings", ExcludeUsing(IndexGist).With(Column("during"), "&&")))
+	fmt.Println(err)
+	// Output:
+	// CREATE TABLE bookings (
+	// 	room INTEGER,
+	// 	during tsrange,
+	// 	cancelled BOOLEAN,
+	// 	CONSTRAINT no_overlap EXCLUDE USING gist (room WITH =, during WITH &&) WHERE (NOT cancelled)
+	// )
+	// ALTER TABLE bookings ADD EXCLUDE USING gist ((tsrange(starts, ends)) WITH &&)
+	// xql: unsupported by the dialect: EXCLUDE
+}
+
+func ExampleCheckConstraintDef_NotEnforced() {
+	fmt.Println(PostgreSQL.XQL(CreateTable("products",
+		Column("price", Numeric, Check(Raw("price > 0")).NotEnforced()),
+		Column("discount", Numeric),
+		Constraint("discount_check").Check(Raw("discount < price")).NotEnforced(),
+		ForeignKey("price").References("prices").NotEnforced(),
+	)))
+	fmt.Println(MySQL.XQL(AlterTable("products").AddConstraint(Check(Raw("price > 0")).NotEnforced())))
+
+	_, err := SQLServer.Build(CreateTable("products", Column("price", Numeric, Check(Raw("price > 0")).NotEnforced())))
+	fmt.Println(err)
+	_, err = MySQL.Build(AlterTable("orders").AddConstraint(ForeignKey("customer_id").References("customers").NotEnforced()))
+	fmt.Println(err)
+	// Output:
+	// CREATE TABLE products (
+	// 	price NUMERIC CHECK (price > 0) NOT ENFORCED,
+	// 	discount NUMERIC,
+	// 	CONSTRAINT discount_check CHECK (discount < price) NOT ENFORCED,
+	// 	FOREIGN KEY (price) REFERENCES prices NOT ENFORCED
+	// )
+	// ALTER TABLE products ADD CHECK (price > 0) NOT ENFORCED
+	// xql: unsupported by the dialect: NOT ENFORCED
+	// xql: unsupported by the dialect: NOT ENFORCED on a constraint other than CHECK
+}
@@ -0,0 +1,319 @@
+package xql
+
+import (
+	"strconv"
+	"strings"
+)
+
+//go:generate stringer -type CopyFormat -linecomment
+
+type CopyFormat int
+
+const (
+	CopyText   CopyFormat = iota // text
+	CopyCSV                      // csv
+	CopyBinary                   // binary
+)
+
+// CopyStmt copies the rows between a table, or a query, and a file or the client on PostgreSQL.
+//
+//	COPY table_name [ ( column_name [, ...] ) ] FROM { 'filename' | STDIN } [ [ WITH ] ( option [, ...] ) ]
+//	COPY { table_name [ ( column_name [, ...] ) ] | ( query ) } TO { 'filename' | STDOUT } [ [ WITH ] ( option [, ...] ) ]
+//
+// https://www.postgresql.org/docs/current/sql-copy.html
+type CopyStmt struct {
+	Table   *TableName
+	Columns ColumnNameList
+	Query   QueryExprBody
+	To      bool
+	File    string
+	Options []*CopyOption
+}
+
+// CopyFrom copies the rows from the client into the table.
+func CopyFrom[T ToTableName](table T, x ...ColumnName) *CopyStmt {
+	return &CopyStmt{Table: newTableName(table), Columns: x}
+}
+
+// CopyTo copies the rows of the table to the client.
+func CopyTo[T ToTableName](table T, x ...ColumnName) *CopyStmt {
+	return &CopyStmt{Table: newTableName(table), Columns: x, To: true}
+}
+
+// CopyQueryTo copies the rows of the query to the client.
+func CopyQueryTo(query QueryExprBody) *CopyStmt {
+	return &CopyStmt{Query: query, To: true}
+}
+
+// CopyOption is an option of the COPY statement.
+type CopyOption struct {
+	Name  Keyword
+	Value Accepter
+}
+
+func (o *CopyOption) Accept(v Visitor) Visitor { return v.Visit(o.Name).IfNotNil(o.Value, WS, o.Value) }
+func (o *CopyOption) String() string           { return XQL(o) }
+
+func (s *CopyStmt) option(name Keyword, value Accepter) *CopyStmt {
+	s.Options = append(s.Options, &CopyOption{name, value})
+	return s
+}
+
+// Path reads or writes the file on the server instead of the client.
+func (s *CopyStmt) Path(file string) *CopyStmt {
+	s.File = file
+	return s
+}
+
+func (s *CopyStmt) Format(f CopyFormat) *CopyStmt { return s.option(kFormat, Stringer(f)) }
+func (s *CopyStmt) Header() *CopyStmt             { return s.option(kHeader, nil) }
+func (s *CopyStmt) Freeze() *CopyStmt             { return s.option(kFreeze, nil) }
+func (s *CopyStmt) Delimiter(d string) *CopyStmt  { return s.option(kDelimiter, literal(d)) }
+func (s *CopyStmt) Null(n string) *CopyStmt       { return s.option(kNull, literal(n)) }
+func (s *CopyStmt) Quote(q string) *CopyStmt      { return s.option(kQuote, literal(q)) }
+func (s *CopyStmt) Escape(e string) *CopyStmt     { return s.option(kEscape, literal(e)) }
+func (s *CopyStmt) Encoding(e string) *CopyStmt   { return s.option(kEncoding, literal(e)) }
+
+// ForceQuote quotes the non-NULL values of the columns, or every column without columns.
+func (s *CopyStmt) ForceQuote(x ...ColumnName) *CopyStmt {
+	if len(x) == 0 {
+		return s.option(kForceQuote, Raw("*"))
+	}
+
+	return s.option(kForceQuote, ColumnNameList(x))
+}
+
+const (
+	kCopy       = Keyword("COPY")
+	kTo         = Keyword("TO")
+	kStdin      = Keyword("STDIN")
+	kStdout     = Keyword("STDOUT")
+	kFormat     = Keyword("FORMAT")
+	kHeader     = Keyword("HEADER")
+	kFreeze     = Keyword("FREEZE")
+	kDelimiter  = Keyword("DELIMITER")
+	kQuote      = Keyword("QUOTE")
+	kEscape     = Keyword("ESCAPE")
+	kEncoding   = Keyword("ENCODING")
+	kForceQuote = Keyword("FORCE_QUOTE")
+)
+
+func (s *CopyStmt) Accept(v Visitor) Visitor {
+	only(v, "COPY", PostgreSQL)
+
+	return v.Visit(kCopy, WS).
+		IfElse(s.Query != nil, Paren(accept(s.Query)), AcceptFunc(func(v Visitor) Visitor {
+			return v.Visit(s.Table).IfNotNil(s.Columns, WS, s.Columns)
+		})).
+		Visit(WS).
+		IfElse(s.To, kTo, kFrom).
+		Visit(WS).
+		IfElse(len(s.File) > 0, literal(s.File), AcceptFunc(func(v Visitor) Visitor {
+			return v.IfElse(s.To, kStdout, kStdin)
+		})).
+		IfNotNil(s.Options, WS, kWith, WS, Paren(Joins(s.Options, Sep)))
+}
+
+func (s *CopyStmt) String() string { return XQL(s) }
+
+// literal renders the string as a character string literal.
+func literal(s string) Accepter { return Raw(quoteLiteral(s)) }
+
+// LoadDataStmt reads the rows from a text file into a table on MySQL.
+//
+//	LOAD DATA [LOCAL] INFILE 'file_name' [REPLACE | IGNORE] INTO TABLE tbl_name
+//		[{FIELDS | COLUMNS} [TERMINATED BY 'string'] [[OPTIONALLY] ENCLOSED BY 'char'] [ESCAPED BY 'char']]
+//		[LINES [STARTING BY 'string'] [TERMINATED BY 'string']]
+//		[IGNORE number {LINES | ROWS}]
+//		[(col_name_or_user_var [, col_name_or_user_var] ...)]
+//
+// https://dev.mysql.com/doc/refman/8.0/en/load-data.html
+type LoadDataStmt struct {
+	LocalFile          bool
+	File               string
+	Duplicates         Keyword
+	Table              *TableName
+	FieldTerminator    *string
+	FieldEnclosure     *string
+	OptionallyEnclosed bool
+	FieldEscape        *string
+	LineTerminator     *string
+	SkipLines          int
+	Columns            ColumnNameList
+}
+
+// LoadDataInfile reads the rows from the file on the server into the table.
+func LoadDataInfile[T ToTableName](file string, table T, x ...ColumnName) *LoadDataStmt {
+	return &LoadDataStmt{File: file, Table: newTableName(table), Columns: x}
+}
+
+// Local reads the file on the client.
+func (s *LoadDataStmt) Local() *LoadDataStmt {
+	s.LocalFile = true
+	return s
+}
+
+// Replace replaces the existing rows which have the same unique key.
+func (s *LoadDataStmt) Replace() *LoadDataStmt {
+	s.Duplicates = kReplace
+	return s
+}
+
+// Ignore skips the rows which duplicate an existing unique key.
+func (s *LoadDataStmt) Ignore() *LoadDataStmt {
+	s.Duplicates = kIgnore
+	return s
+}
+
+func (s *LoadDataStmt) FieldsTerminatedBy(sep string) *LoadDataStmt {
+	s.FieldTerminator = &sep
+	return s
+}
+
+func (s *LoadDataStmt) EnclosedBy(quote string) *LoadDataStmt {
+	s.FieldEnclosure = &quote
+	return s
+}
+
+func (s *LoadDataStmt) OptionallyEnclosedBy(quote string) *LoadDataStmt {
+	s.OptionallyEnclosed = true
+	return s.EnclosedBy(quote)
+}
+
+func (s *LoadDataStmt) EscapedBy(escape string) *LoadDataStmt {
+	s.FieldEscape = &escape
+	return s
+}
+
+func (s *LoadDataStmt) LinesTerminatedBy(sep string) *LoadDataStmt {
+	s.LineTerminator = &sep
+	return s
+}
+
+// IgnoreLines skips the lines at the start of the file, such as a header.
+func (s *LoadDataStmt) IgnoreLines(n int) *LoadDataStmt {
+	s.SkipLines = n
+	return s
+}
+
+const (
+	kLoadData     = Keyword("LOAD DATA")
+	kLocal        = Keyword("LOCAL")
+	kInfile       = Keyword("INFILE")
+	kReplace      = Keyword("REPLACE")
+	kIgnore       = Keyword("IGNORE")
+	kIntoTable    = Keyword("INTO TABLE")
+	kFields       = Keyword("FIELDS")
+	kTerminatedBy = Keyword("TERMINATED BY")
+	kOptionally   = Keyword("OPTIONALLY")
+	kEnclosedBy   = Keyword("ENCLOSED BY")
+	kEscapedBy    = Keyword("ESCAPED BY")
+	kLines        = Keyword("LINES")
+)
+
+func (s *LoadDataStmt) Accept(v Visitor) Visitor {
+	only(v, "LOAD DATA", MySQL)
+
+	fields := s.FieldTerminator != nil || s.FieldEnclosure != nil || s.FieldEscape != nil
+
+	// the backslashes of the file name, e.g. a Windows path, would start escape sequences,
+	// unlike those of the terminators which are given as escape sequences such as \n.
+	file := strings.ReplaceAll(s.File, `\`, `\\`)
+
+	return v.Visit(kLoadData).
+		If(s.LocalFile, WS, kLocal).
+		Visit(WS, kInfile, WS, literal(file)).
+		If(len(s.Duplicates) > 0, WS, s.Duplicates).
+		Visit(WS, kIntoTable, WS, s.Table).
+		If(fields, WS, kFields).
+		IfNotNil(s.FieldTerminator, WS, kTerminatedBy, WS, optionalLiteral(s.FieldTerminator)).
+		If(s.OptionallyEnclosed, WS, kOptionally).
+		IfNotNil(s.FieldEnclosure, WS, kEnclosedBy, WS, optionalLiteral(s.FieldEnclosure)).
+		IfNotNil(s.FieldEscape, WS, kEscapedBy, WS, optionalLiteral(s.FieldEscape)).
+		IfNotNil(s.LineTerminator, WS, kLines, WS, kTerminatedBy, WS, optionalLiteral(s.LineTerminator)).
+		If(s.SkipLines > 0, WS, kIgnore, WS, Int(s.SkipLines), WS, kLines).
+		IfNotNil(s.Columns, WS, s.Columns)
+}
+
+func (s *LoadDataStmt) String() string { return XQL(s) }
+
+func optionalLiteral(s *string) Accepter {
+	if s == nil {
+		return nil
+	}
+
+	return literal(*s)
+}
+
+// BulkInsertStmt imports a data file into a table on SQL Server.
+//
+//	BULK INSERT table_name FROM 'data_file' [ WITH ( option [ ,...n ] ) ]
+//
+// https://learn.microsoft.com/en-us/sql/t-sql/statements/bulk-insert-transact-sql
+type BulkInsertStmt struct {
+	Table   *TableName
+	File    string
+	Options []*BulkInsertOption
+}
+
+// BulkInsert imports the data file on the server into the table.
+func BulkInsert[T ToTableName](table T, file string) *BulkInsertStmt {
+	return &BulkInsertStmt{Table: newTableName(table), File: file}
+}
+
+// BulkInsertOption is an option of the BULK INSERT statement.
+type BulkInsertOption struct {
+	Name  Keyword
+	Value Accepter
+}
+
+func (o *BulkInsertOption) Accept(v Visitor) Visitor {
+	return v.Visit(o.Name).IfNotNil(o.Value, WS, CompEq, WS, o.Value)
+}
+func (o *BulkInsertOption) String() string { return XQL(o) }
+
+func (s *BulkInsertStmt) option(name Keyword, value Accepter) *BulkInsertStmt {
+	s.Options = append(s.Options, &BulkInsertOption{name, value})
+	return s
+}
+
+func (s *BulkInsertStmt) FormatCSV() *BulkInsertStmt { return s.option(kFormat, literal("CSV")) }
+func (s *BulkInsertStmt) FirstRow(n int) *BulkInsertStmt {
+	return s.option(kFirstRow, Raw(strconv.Itoa(n)))
+}
+func (s *BulkInsertStmt) BatchSize(n int) *BulkInsertStmt {
+	return s.option(kBatchSize, Raw(strconv.Itoa(n)))
+}
+func (s *BulkInsertStmt) FieldTerminator(sep string) *BulkInsertStmt {
+	return s.option(kFieldTerminator, literal(sep))
+}
+func (s *BulkInsertStmt) RowTerminator(sep string) *BulkInsertStmt {
+	return s.option(kRowTerminator, literal(sep))
+}
+func (s *BulkInsertStmt) FieldQuote(quote string) *BulkInsertStmt {
+	return s.option(kFieldQuote, literal(quote))
+}
+func (s *BulkInsertStmt) KeepNulls() *BulkInsertStmt    { return s.option(kKeepNulls, nil) }
+func (s *BulkInsertStmt) KeepIdentity() *BulkInsertStmt { return s.option(kKeepIdentity, nil) }
+func (s *BulkInsertStmt) TabLock() *BulkInsertStmt      { return s.option(kTabLock, nil) }
+
+const (
+	kBulkInsert      = Keyword("BULK INSERT")
+	kFirstRow        = Keyword("FIRSTROW")
+	kBatchSize       = Keyword("BATCHSIZE")
+	kFieldTerminator = Keyword("FIELDTERMINATOR")
+	kRowTerminator   = Keyword("ROWTERMINATOR")
+	kFieldQuote      = Keyword("FIELDQUOTE")
+	kKeepNulls       = Keyword("KEEPNULLS")
+	kKeepIdentity    = Keyword("KEEPIDENTITY")
+	kTabLock         = Keyword("TABLOCK")
+)
+
+func (s *BulkInsertStmt) Accept(v Visitor) Visitor {
+	only(v, "BULK INSERT", SQLServer)
+
+	return v.Visit(kBulkInsert, WS, s.Table, WS, kFrom, WS, literal(s.File)).
+		IfNotNil(s.Options, WS, kWith, WS, Paren(Joins(s.Options, Sep)))
+}
+
+func (s *BulkInsertStmt) String() string { return XQL(s) }
@@ -0,0 +1,227 @@
+package xql
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CopyEncoder streams the rows in the format read by COPY ... FROM STDIN.
+//
+// The text and CSV formats encode the values as their text representation,
+// the binary format encodes them as the PostgreSQL type matching the Go type:
+// bool, int2, int4, int8, float4, float8, text, bytea and timestamp.
+type CopyEncoder struct {
+	w         io.Writer
+	format    CopyFormat
+	Delimiter byte
+	Null      string
+	buf       []byte
+	started   bool
+}
+
+// NewCopyEncoder encodes the rows in the format with the default delimiter and NULL string of the format.
+func NewCopyEncoder(w io.Writer, format CopyFormat) *CopyEncoder {
+	e := &CopyEncoder{w: w, format: format, Delimiter: '\t', Null: `\N`}
+
+	if format == CopyCSV {
+		e.Delimiter, e.Null = ',', ""
+	}
+
+	return e
+}
+
+var copyBinarySignature = []byte("PGCOPY\n\xff\r\n\x00")
+
+// Encode writes a row of values.
+func (e *CopyEncoder) Encode(row ...any) (err error) {
+	e.buf = e.buf[:0]
+
+	if e.format == CopyBinary {
+		if !e.started {
+			e.buf = append(e.buf, copyBinarySignature...)
+			e.buf = binary.BigEndian.AppendUint32(e.buf, 0) // flags
+			e.buf = binary.BigEndian.AppendUint32(e.buf, 0) // header extension length
+		}
+
+		e.buf = binary.BigEndian.AppendUint16(e.buf, uint16(len(row)))
+	}
+
+	for i, value := range row {
+		if value, err = copyValue(value); err != nil {
+			return fmt.Errorf("xql: column %d: %w", i, err)
+		}
+
+		switch e.format {
+		case CopyBinary:
+			e.buf, err = appendCopyBinary(e.buf, value)
+		default:
+			if i > 0 {
+				e.buf = append(e.buf, e.Delimiter)
+			}
+
+			e.buf = e.appendText(e.buf, value)
+		}
+
+		if err != nil {
+			return fmt.Errorf("xql: column %d: %w", i, err)
+		}
+	}
+
+	if e.format != CopyBinary {
+		e.buf = append(e.buf, '\n')
+	}
+
+	e.started = true
+	_, err = e.w.Write(e.buf)
+
+	return
+}
+
+// Close writes the trailer of the binary format.
+func (e *CopyEncoder) Close() error {
+	if e.format != CopyBinary {
+		return nil
+	}
+
+	var b []byte
+	if !e.started {
+		b = append(b, copyBinarySignature...)
+		b = binary.BigEndian.AppendUint64(b, 0)
+	}
+
+	_, err := e.w.Write(binary.BigEndian.AppendUint16(b, 0xffff))
+
+	return err
+}
+
+// copyValue dereferences the pointers and converts the driver.Valuer values.
+func copyValue(value any) (any, error) {
+	if valuer, ok := value.(driver.Valuer); ok {
+		if v := reflect.ValueOf(value); v.Kind() == reflect.Ptr && v.IsNil() {
+			return nil, nil
+		}
+
+		return valuer.Value()
+	}
+
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, nil
+		}
+
+		return copyValue(v.Elem().Interface())
+	}
+
+	return value, nil
+}
+
+func (e *CopyEncoder) appendText(b []byte, value any) []byte {
+	if value == nil {
+		return append(b, e.Null...)
+	}
+
+	var s string
+
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = `\x` + hex.EncodeToString(v)
+	case bool:
+		s = "f"
+		if v {
+			s = "t"
+		}
+	case float32:
+		s = strconv.FormatFloat(float64(v), 'g', -1, 32)
+	case float64:
+		s = strconv.FormatFloat(v, 'g', -1, 64)
+	case time.Time:
+		s = v.Format("2006-01-02 15:04:05.999999999Z07:00")
+	default:
+		s = fmt.Sprint(v)
+	}
+
+	if e.format == CopyCSV {
+		if s == e.Null || strings.ContainsAny(s, string([]byte{e.Delimiter, '"', '\r', '\n'})) {
+			return append(append(append(b, '"'), strings.ReplaceAll(s, `"`, `""`)...), '"')
+		}
+
+		return append(b, s...)
+	}
+
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\\':
+			b = append(b, `\\`...)
+		case '\n':
+			b = append(b, `\n`...)
+		case '\r':
+			b = append(b, `\r`...)
+		case '\t':
+			b = append(b, `\t`...)
+		case e.Delimiter:
+			b = append(b, '\\', c)
+		default:
+			b = append(b, c)
+		}
+	}
+
+	return b
+}
+
+// postgresEpoch is the origin of the PostgreSQL timestamps.
+var postgresEpoch = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+func appendCopyBinary(b []byte, value any) ([]byte, error) {
+	field := func(data ...byte) []byte {
+		return append(binary.BigEndian.AppendUint32(b, uint32(len(data))), data...)
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return binary.BigEndian.AppendUint32(b, math.MaxUint32), nil
+	case bool:
+		if v {
+			return field(1), nil
+		}
+		return field(0), nil
+	case int8:
+		return field(binary.BigEndian.AppendUint16(nil, uint16(v))...), nil
+	case int16:
+		return field(binary.BigEndian.AppendUint16(nil, uint16(v))...), nil
+	case uint8:
+		return field(binary.BigEndian.AppendUint16(nil, uint16(v))...), nil
+	case int32:
+		return field(binary.BigEndian.AppendUint32(nil, uint32(v))...), nil
+	case uint16:
+		return field(binary.BigEndian.AppendUint32(nil, uint32(v))...), nil
+	case int:
+		return field(binary.BigEndian.AppendUint64(nil, uint64(v))...), nil
+	case int64:
+		return field(binary.BigEndian.AppendUint64(nil, uint64(v))...), nil
+	case uint32:
+		return field(binary.BigEndian.AppendUint64(nil, uint64(v))...), nil
+	case float32:
+		return field(binary.BigEndian.AppendUint32(nil, math.Float32bits(v))...), nil
+	case float64:
+		return field(binary.BigEndian.AppendUint64(nil, math.Float64bits(v))...), nil
+	case string:
+		return field([]byte(v)...), nil
+	case []byte:
+		return field(v...), nil
+	case time.Time:
+		// Sub would saturate beyond about 292 years, e.g. for the zero time.
+		return field(binary.BigEndian.AppendUint64(nil, uint64(v.UnixMicro()-postgresEpoch.UnixMicro()))...), nil
+	default:
+		return b, fmt.Errorf("%w: binary COPY of %T", ErrUnsupported, value)
+	}
+}
@@ -0,0 +1,58 @@
+package xql_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"time"
+
+	. "github.com/flier/xql"
+)
+
+func ExampleCopyFrom() {
+	fmt.Println(CopyFrom("films", "code", "title").Format(CopyCSV).Header().Delimiter(";"))
+	fmt.Println(CopyQueryTo(Select(Asterisk).From(QName("films")).Where(Raw("len > 120"))).Format(CopyBinary))
+	fmt.Println(CopyTo("country").Path("/usr1/proj/bray/sql/country_data"))
+	fmt.Println(MySQL.XQL(LoadDataInfile("/tmp/films.csv", "films", "code", "title").
+		Local().FieldsTerminatedBy(",").OptionallyEnclosedBy(`"`).LinesTerminatedBy(`\n`).IgnoreLines(1)))
+	fmt.Println(MySQL.XQL(LoadDataInfile(`C:\data\films.csv`, "films")))
+	fmt.Println(SQLServer.XQL(BulkInsert("films", `C:\data\films.csv`).FormatCSV().FirstRow(2).TabLock()))
+
+	_, err := MySQL.Build(CopyFrom("films"))
+	fmt.Println(err)
+	// Output:
+	// COPY films (code, title) FROM STDIN WITH (FORMAT csv, HEADER, DELIMITER ';')
+	// COPY (SELECT * FROM films WHERE len > 120) TO STDOUT WITH (FORMAT binary)
+	// COPY country TO '/usr1/proj/bray/sql/country_data'
+	// LOAD DATA LOCAL INFILE '/tmp/films.csv' INTO TABLE films FIELDS TERMINATED BY ',' OPTIONALLY ENCLOSED BY '"' LINES TERMINATED BY '\n' IGNORE 1 LINES (code, title)
+	// LOAD DATA INFILE 'C:\\data\\films.csv' INTO TABLE films
+	// BULK INSERT films FROM 'C:\data\films.csv' WITH (FORMAT = 'CSV', FIRSTROW = 2, TABLOCK)
+	// xql: unsupported by the dialect: COPY
+}
+
+func ExampleCopyEncoder() {
+	text := NewCopyEncoder(os.Stdout, CopyText)
+	text.Encode(1, "tab\there", nil, true)
+
+	csv := NewCopyEncoder(os.Stdout, CopyCSV)
+	csv.Encode(2, `say "hi"`, nil, "")
+
+	var b bytes.Buffer
+	bin := NewCopyEncoder(&b, CopyBinary)
+	bin.Encode(int32(3), "x", nil)
+	bin.Close()
+	fmt.Printf("%q\n", b.String())
+	// Output:
+	// 1	tab\there	\N	t
+	// 2,"say ""hi""",,""
+	// "PGCOPY\n\xff\r\n\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x04\x00\x00\x00\x03\x00\x00\x00\x01x\xff\xff\xff\xff\xff\xff"
+}
+
+func ExampleCopyEncoder_time() {
+	var b bytes.Buffer
+	bin := NewCopyEncoder(&b, CopyBinary)
+	bin.Encode(time.Date(2000, time.January, 1, 0, 0, 1, 0, time.UTC), time.Time{})
+	fmt.Printf("% x\n", b.Bytes()[19:])
+	// Output:
+	// 00 02 00 00 00 08 00 00 00 00 00 0f 42 40 00 00 00 08 ff 1f e2 ff c5 9c 60 00
+}
@@ -1,3 +1,284 @@
 package xql
 
+import (
+	"fmt"
+	"strings"
+)
+
 type CursorName = LocalQualifiedName
+
+//go:generate stringer -type CursorSensitivity -linecomment
+
+type CursorSensitivity int
+
+const (
+	CursorAsensitive  CursorSensitivity = iota // ASENSITIVE
+	CursorInsensitive                          // INSENSITIVE
+	CursorSensitive                            // SENSITIVE
+)
+
+//go:generate stringer -type CursorScrollability -linecomment
+
+type CursorScrollability int
+
+const (
+	CursorNoScroll CursorScrollability = iota // NO SCROLL
+	CursorScroll                              // SCROLL
+)
+
+//go:generate stringer -type CursorHoldability -linecomment
+
+type CursorHoldability int
+
+const (
+	CursorWithoutHold CursorHoldability = iota // WITHOUT HOLD
+	CursorWithHold                             // WITH HOLD
+)
+
+//go:generate stringer -type CursorReturnability -linecomment
+
+type CursorReturnability int
+
+const (
+	CursorWithoutReturn CursorReturnability = iota // WITHOUT RETURN
+	CursorWithReturn                               // WITH RETURN
+)
+
+// DeclareCursorStmt declares a cursor over the rows of a query.
+//
+//	<declare cursor> ::=
+//		DECLARE <cursor name> <cursor properties> FOR <cursor specification>
+//	<cursor properties> ::=
+//		[ <cursor sensitivity> ] [ <cursor scrollability> ] CURSOR
+//		[ <cursor holdability> ] [ <cursor returnability> ]
+//
+// PostgreSQL supports all the properties but SENSITIVE and the returnability,
+// SQL Server only INSENSITIVE and SCROLL, MySQL and Oracle none of them.
+//
+// https://jakewheat.github.io/sql-overview/sql-2016-foundation-grammar.html#declare-cursor
+type DeclareCursorStmt struct {
+	Name          *CursorName
+	Sensitivity   *CursorSensitivity
+	Scrollability *CursorScrollability
+	Holdability   *CursorHoldability
+	Returnability *CursorReturnability
+	Query         QueryExprBody
+}
+
+// DeclareCursor declares a cursor over the rows of the query.
+func DeclareCursor[T ToLocalQualifiedName](name T, query QueryExprBody) *DeclareCursorStmt {
+	return &DeclareCursorStmt{Name: LocalQName(name), Query: query}
+}
+
+func (s *DeclareCursorStmt) sensitivity(x CursorSensitivity) *DeclareCursorStmt {
+	s.Sensitivity = &x
+	return s
+}
+
+func (s *DeclareCursorStmt) Asensitive() *DeclareCursorStmt  { return s.sensitivity(CursorAsensitive) }
+func (s *DeclareCursorStmt) Insensitive() *DeclareCursorStmt { return s.sensitivity(CursorInsensitive) }
+func (s *DeclareCursorStmt) Sensitive() *DeclareCursorStmt   { return s.sensitivity(CursorSensitive) }
+
+func (s *DeclareCursorStmt) scrollability(x CursorScrollability) *DeclareCursorStmt {
+	s.Scrollability = &x
+	return s
+}
+
+func (s *DeclareCursorStmt) NoScroll() *DeclareCursorStmt { return s.scrollability(CursorNoScroll) }
+func (s *DeclareCursorStmt) Scroll() *DeclareCursorStmt   { return s.scrollability(CursorScroll) }
+
+func (s *DeclareCursorStmt) holdability(x CursorHoldability) *DeclareCursorStmt {
+	s.Holdability = &x
+	return s
+}
+
+func (s *DeclareCursorStmt) WithoutHold() *DeclareCursorStmt { return s.holdability(CursorWithoutHold) }
+func (s *DeclareCursorStmt) WithHold() *DeclareCursorStmt    { return s.holdability(CursorWithHold) }
+
+func (s *DeclareCursorStmt) returnability(x CursorReturnability) *DeclareCursorStmt {
+	s.Returnability = &x
+	return s
+}
+
+func (s *DeclareCursorStmt) WithoutReturn() *DeclareCursorStmt {
+	return s.returnability(CursorWithoutReturn)
+}
+func (s *DeclareCursorStmt) WithReturn() *DeclareCursorStmt { return s.returnability(CursorWithReturn) }
+
+const (
+	kDeclare = Keyword("DECLARE")
+	kCursor  = Keyword("CURSOR")
+	kFor     = Keyword("FOR")
+)
+
+func (s *DeclareCursorStmt) Accept(v Visitor) Visitor {
+	if s.Sensitivity != nil {
+		what := s.Sensitivity.String() + " " + kCursor.String()
+
+		switch *s.Sensitivity {
+		case CursorInsensitive:
+			only(v, what, PostgreSQL, SQLServer)
+		case CursorAsensitive:
+			only(v, what, PostgreSQL)
+		default:
+			only(v, what)
+		}
+	}
+
+	if s.Scrollability != nil {
+		what := s.Scrollability.String() + " " + kCursor.String()
+
+		if *s.Scrollability == CursorScroll {
+			only(v, what, PostgreSQL, SQLServer)
+		} else {
+			only(v, what, PostgreSQL)
+		}
+	}
+
+	if s.Holdability != nil {
+		only(v, kCursor.String()+" "+s.Holdability.String(), PostgreSQL)
+	}
+
+	if s.Returnability != nil {
+		only(v, kCursor.String()+" "+s.Returnability.String())
+	}
+
+	return v.Visit(kDeclare, WS, s.Name).
+		IfNotNil(s.Sensitivity, WS, Stringer(s.Sensitivity)).
+		IfNotNil(s.Scrollability, WS, Stringer(s.Scrollability)).
+		Visit(WS, kCursor).
+		IfNotNil(s.Holdability, WS, Stringer(s.Holdability)).
+		IfNotNil(s.Returnability, WS, Stringer(s.Returnability)).
+		Visit(WS, kFor, WS, accept(s.Query))
+}
+
+func (s *DeclareCursorStmt) String() string { return XQL(s) }
+
+// OpenStmt opens a cursor.
+//
+//	<open statement> ::= OPEN <cursor name>
+type OpenStmt struct {
+	Name *CursorName
+}
+
+func Open[T ToLocalQualifiedName](name T) *OpenStmt { return &OpenStmt{LocalQName(name)} }
+
+const kOpen = Keyword("OPEN")
+
+func (s *OpenStmt) Accept(v Visitor) Visitor { return v.Visit(kOpen, WS, s.Name) }
+func (s *OpenStmt) String() string           { return XQL(s) }
+
+// CloseStmt closes a cursor.
+//
+//	<close statement> ::= CLOSE <cursor name>
+type CloseStmt struct {
+	Name *CursorName
+}
+
+func Close[T ToLocalQualifiedName](name T) *CloseStmt { return &CloseStmt{LocalQName(name)} }
+
+const kClose = Keyword("CLOSE")
+
+func (s *CloseStmt) Accept(v Visitor) Visitor { return v.Visit(kClose, WS, s.Name) }
+func (s *CloseStmt) String() string           { return XQL(s) }
+
+//go:generate stringer -type FetchOrientationKind -linecomment
+
+type FetchOrientationKind int
+
+const (
+	FetchNext       FetchOrientationKind = iota // NEXT
+	FetchPrior                                  // PRIOR
+	FetchFirst                                  // FIRST
+	FetchLast                                   // LAST
+	FetchAbsolute                               // ABSOLUTE
+	FetchRelative                               // RELATIVE
+	FetchForwardAll                             // FORWARD ALL
+)
+
+// FetchOrientation positions the cursor before fetching or moving.
+//
+//	<fetch orientation> ::=
+//		NEXT | PRIOR | FIRST | LAST
+//		| { ABSOLUTE | RELATIVE } <simple value specification>
+//
+// MySQL only fetches the NEXT row, and Oracle has no orientation.
+type FetchOrientation struct {
+	Kind  FetchOrientationKind
+	Count int
+}
+
+func (o *FetchOrientation) Accept(v Visitor) Visitor {
+	what := kFetch.String() + " " + o.Kind.String()
+
+	switch o.Kind {
+	case FetchNext:
+		only(v, what, PostgreSQL, MySQL, SQLServer)
+	case FetchForwardAll:
+		only(v, what, PostgreSQL)
+	default:
+		only(v, what, PostgreSQL, SQLServer)
+	}
+
+	return v.Visit(Stringer(o.Kind)).If(o.Kind == FetchAbsolute || o.Kind == FetchRelative, WS, Int(o.Count))
+}
+
+func (o *FetchOrientation) String() string { return XQL(o) }
+
+// FetchStmt fetches a row from a cursor, or moves the cursor without fetching on PostgreSQL.
+//
+//	<fetch statement> ::=
+//		FETCH [ [ <fetch orientation> ] FROM ] <cursor name> INTO <fetch target list>
+type FetchStmt struct {
+	Move        bool
+	Orientation *FetchOrientation
+	Name        *CursorName
+	Targets     []VarName
+}
+
+// Fetch fetches the next row from the cursor.
+func Fetch[T ToLocalQualifiedName](name T) *FetchStmt { return &FetchStmt{Name: LocalQName(name)} }
+
+// Move moves the cursor without fetching, it is only supported by PostgreSQL.
+func Move[T ToLocalQualifiedName](name T) *FetchStmt {
+	return &FetchStmt{Move: true, Name: LocalQName(name)}
+}
+
+func (s *FetchStmt) orientation(kind FetchOrientationKind, n int) *FetchStmt {
+	s.Orientation = &FetchOrientation{kind, n}
+	return s
+}
+
+func (s *FetchStmt) Next() *FetchStmt          { return s.orientation(FetchNext, 0) }
+func (s *FetchStmt) Prior() *FetchStmt         { return s.orientation(FetchPrior, 0) }
+func (s *FetchStmt) First() *FetchStmt         { return s.orientation(FetchFirst, 0) }
+func (s *FetchStmt) Last() *FetchStmt          { return s.orientation(FetchLast, 0) }
+func (s *FetchStmt) Absolute(n int) *FetchStmt { return s.orientation(FetchAbsolute, n) }
+func (s *FetchStmt) Relative(n int) *FetchStmt { return s.orientation(FetchRelative, n) }
+
+// ForwardAll fetches all the remaining rows, it is only supported by PostgreSQL.
+func (s *FetchStmt) ForwardAll() *FetchStmt { return s.orientation(FetchForwardAll, 0) }
+
+// Into stores the fetched columns into the variables.
+func (s *FetchStmt) Into(x ...VarName) *FetchStmt {
+	s.Targets = x
+	return s
+}
+
+const (
+	kFetch = Keyword("FETCH")
+	kMove  = Keyword("MOVE")
+)
+
+func (s *FetchStmt) Accept(v Visitor) Visitor {
+	if s.Move && !v.Dialect().Is(StandardSQL, PostgreSQL) {
+		v.Fail(fmt.Errorf("%w: MOVE", ErrUnsupported))
+	}
+
+	return v.IfElse(s.Move, kMove, kFetch).
+		IfNotNil(s.Orientation, WS, s.Orientation).
+		Visit(WS, kFrom, WS, s.Name).
+		If(len(s.Targets) > 0, WS, kInto, WS, Raw(strings.Join(s.Targets, ", ")))
+}
+
+func (s *FetchStmt) String() string { return XQL(s) }
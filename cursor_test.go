@@ -0,0 +1,46 @@
+package xql_test
+
+import (
+	"fmt"
+
+	. "github.com/flier/xql"
+)
+
+func ExampleDeclareCursor() {
+	fmt.Println(DeclareCursor("liahona", Select(Asterisk).From(QName("films"))).Insensitive().Scroll().WithHold())
+	fmt.Println(Open("liahona"))
+	fmt.Println(Fetch("liahona").Next())
+	fmt.Println(Fetch("liahona").Absolute(5).Into(":title", ":len"))
+	fmt.Println(Fetch("liahona").ForwardAll())
+	fmt.Println(Move("liahona").Relative(-2))
+	fmt.Println(Close("liahona"))
+
+	_, err := MySQL.Build(Move("liahona").Prior())
+	fmt.Println(err)
+	_, err = SQLServer.Build(Fetch("liahona").ForwardAll())
+	fmt.Println(err)
+	_, err = MySQL.Build(Fetch("liahona").Absolute(5))
+	fmt.Println(err)
+	_, err = SQLServer.Build(DeclareCursor("liahona", Select(Asterisk).From(QName("films"))).WithHold())
+	fmt.Println(err)
+	_, err = MySQL.Build(DeclareCursor("liahona", Select(Asterisk).From(QName("films"))).Scroll())
+	fmt.Println(err)
+	_, err = PostgreSQL.Build(DeclareCursor("liahona", Select(Asterisk).From(QName("films"))).Sensitive())
+	fmt.Println(err)
+	fmt.Println(SQLServer.XQL(DeclareCursor("liahona", Select(Asterisk).From(QName("films"))).Insensitive().Scroll()))
+	// Output:
+	// DECLARE liahona INSENSITIVE SCROLL CURSOR WITH HOLD FOR SELECT * FROM films
+	// OPEN liahona
+	// FETCH NEXT FROM liahona
+	// FETCH ABSOLUTE 5 FROM liahona INTO :title, :len
+	// FETCH FORWARD ALL FROM liahona
+	// MOVE RELATIVE -2 FROM liahona
+	// CLOSE liahona
+	// xql: unsupported by the dialect: MOVE
+	// xql: unsupported by the dialect: FETCH FORWARD ALL
+	// xql: unsupported by the dialect: FETCH ABSOLUTE
+	// xql: unsupported by the dialect: CURSOR WITH HOLD
+	// xql: unsupported by the dialect: SCROLL CURSOR
+	// xql: unsupported by the dialect: SENSITIVE CURSOR
+	// DECLARE liahona INSENSITIVE SCROLL CURSOR FOR SELECT * FROM films
+}
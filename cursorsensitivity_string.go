@@ -0,0 +1,25 @@
+// Code generated by "stringer -type CursorSensitivity -linecomment"; DO NOT EDIT.
+
+package xql
+
+import "strconv"
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[CursorAsensitive-0]
+	_ = x[CursorInsensitive-1]
+	_ = x[CursorSensitive-2]
+}
+
+const _CursorSensitivity_name = "ASENSITIVEINSENSITIVESENSITIVE"
+
+var _CursorSensitivity_index = [...]uint8{0, 10, 21, 30}
+
+func (i CursorSensitivity) String() string {
+	if i < 0 || i >= CursorSensitivity(len(_CursorSensitivity_index)-1) {
+		return "CursorSensitivity(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _CursorSensitivity_name[_CursorSensitivity_index[i]:_CursorSensitivity_index[i+1]]
+}
@@ -1,15 +1,16 @@
 package xql
 
-import (
-	"fmt"
-	"strings"
-)
+import "fmt"
 
 type DeleteStmt struct {
-	Target TargetTable
-	Alias  CorrelationName
-	Cursor *CursorName
-	Search SearchCond
+	Target  TargetTable
+	Alias   CorrelationName
+	Sources TableRefList
+	Cursor  *CursorName
+	Search  SearchCond
+	Return  *ReturningClause
+	Order   OrderByClause
+	Limits  *LimitClause
 }
 
 type DeleteOption interface {
@@ -33,6 +34,13 @@ func (s *DeleteStmt) As(name string) *DeleteStmt {
 	return s
 }
 
+// Using joins the target table with the other tables,
+// it is rendered as the multi-table form on MySQL and SQL Server.
+func (s *DeleteStmt) Using(x ...ToTableRef) *DeleteStmt {
+	s.Sources = append(s.Sources, TableRefList(From(x...))...)
+	return s
+}
+
 func (s *DeleteStmt) Where(search SearchCond) *DeleteStmt {
 	s.Search = search
 	return s
@@ -43,20 +51,81 @@ func (s *DeleteStmt) WhereCurrentOf(cursor string) *DeleteStmt {
 	return s
 }
 
-func (s *DeleteStmt) String() string {
-	var b strings.Builder
+// OrderBy deletes the rows in order, it is supported on single-table deletes by MySQL and SQLite.
+func (s *DeleteStmt) OrderBy(x ...ToSortSpec) *DeleteStmt {
+	s.Order = OrderBy(x...)
+	return s
+}
+
+// Limit deletes at most n rows, it is supported on single-table deletes by MySQL and SQLite.
+func (s *DeleteStmt) Limit(n int) *DeleteStmt {
+	s.Limits = Limit(intValue(n))
+	return s
+}
+
+// Returning returns the columns of the deleted rows.
+func (s *DeleteStmt) Returning(x ...SelectFieldOrAsterisk) *DeleteStmt {
+	s.Return = Returning(x...)
+	return s
+}
+
+// ReturningInto stores the returned columns into the variables, as required by Oracle,
+// the columns are given by Returning.
+func (s *DeleteStmt) ReturningInto(vars ...VarName) *DeleteStmt {
+	if s.Return == nil {
+		s.Return = &ReturningClause{}
+	}
+
+	s.Return.Into = vars
+	return s
+}
+
+const (
+	kDelete     = Keyword("DELETE")
+	kDeleteFrom = Keyword("DELETE FROM")
+)
+
+func (s *DeleteStmt) Accept(v Visitor) Visitor {
+	d := v.Dialect()
+
+	if s.Sources != nil && d.Is(SQLite, Oracle) {
+		v.Fail(fmt.Errorf("%w: DELETE ... USING", ErrUnsupported))
+	}
+
+	if (s.Order != nil || s.Limits != nil) && !orderedModification(d, s.Sources) {
+		v.Fail(fmt.Errorf("%w: DELETE ... ORDER BY/LIMIT", ErrUnsupported))
+	}
+
+	target := aliasedTarget(s.Target, s.Alias)
 
-	fmt.Fprintf(&b, "DELETE FROM %s", s.Target)
+	// MySQL and SQL Server name the deleted table, or its alias, before the joined tables
+	multiTable := d == MySQL && s.Sources != nil || d == SQLServer && (s.Sources != nil || len(s.Alias) > 0)
 
-	if len(s.Alias) > 0 {
-		fmt.Fprintf(&b, " AS %s", s.Alias)
+	if multiTable {
+		v.Visit(kDelete, WS).
+			IfElse(len(s.Alias) > 0, Raw(s.Alias), accept(s.Target))
+	} else {
+		v.Visit(kDeleteFrom, WS, target)
 	}
 
-	if s.Cursor != nil {
-		fmt.Fprintf(&b, " WHERE CURRENT OF %s", s.Cursor)
-	} else if s.Search != nil {
-		fmt.Fprintf(&b, " WHERE %s", s.Search)
+	if s.Return != nil && d == SQLServer {
+		v.Visit(WS, s.Return.output(kDeleted))
 	}
 
-	return b.String()
+	if multiTable {
+		v.Visit(WS, kFrom, WS, target).IfNotNil(s.Sources, Sep, s.Sources)
+	} else {
+		v.IfNotNil(s.Sources, WS, kUsing, WS, s.Sources)
+	}
+
+	return v.IfElse(s.Cursor != nil, AcceptFunc(func(v Visitor) Visitor {
+		return v.Visit(WS, kWhereCurrentOf, WS, s.Cursor)
+	}), AcceptFunc(func(v Visitor) Visitor {
+		return v.IfNotNil(s.Search, WS, kWhere, WS, accept(s.Search))
+	})).
+		If(s.Return != nil && d != SQLServer, WS, s.Return).
+		IfNotNil(s.Order, WS, accept(s.Order)).
+		IfNotNil(s.Limits, WS, accept(s.Limits))
 }
+
+func (s *DeleteStmt) String() string { return XQL(s) }
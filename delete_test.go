@@ -14,8 +14,26 @@ func ExampleDeleteFrom() {
 	fmt.Println(DeleteFrom("products").WhereCurrentOf("c_tasks"))
 	// Output:
 	// DELETE FROM products
-	// DELETE FROM ONLY products
+	// DELETE FROM ONLY (products)
 	// DELETE FROM products AS p
 	// DELETE FROM products WHERE price = 10
 	// DELETE FROM products WHERE CURRENT OF c_tasks
 }
+
+func ExampleDeleteStmt_Using() {
+	remove := DeleteFrom("films").
+		Using(InnerJoin(QName("producers").As("p"), QName("studios").As("s")).On(Raw("p.studio_id = s.id"))).
+		Where(Raw("films.producer_id = p.id AND s.name = 'foo'"))
+
+	for _, d := range []Dialect{PostgreSQL, MySQL, SQLServer} {
+		fmt.Println(d.XQL(remove))
+	}
+
+	_, err := PostgreSQL.Build(DeleteFrom("logs").OrderBy(&SortSpec{Key: Raw("id")}).Limit(10))
+	fmt.Println(err)
+	// Output:
+	// DELETE FROM films USING producers AS p JOIN studios AS s ON p.studio_id = s.id WHERE films.producer_id = p.id AND s.name = 'foo'
+	// DELETE films FROM films, producers AS p JOIN studios AS s ON p.studio_id = s.id WHERE films.producer_id = p.id AND s.name = 'foo'
+	// DELETE films FROM films, producers AS p JOIN studios AS s ON p.studio_id = s.id WHERE films.producer_id = p.id AND s.name = 'foo'
+	// xql: unsupported by the dialect: DELETE ... ORDER BY/LIMIT
+}
@@ -0,0 +1,65 @@
+package xql
+
+import "fmt"
+
+//go:generate stringer -type=Dialect -linecomment
+
+// Dialect is the flavor of SQL a statement is rendered in.
+//
+// Most constructs are rendered the same way everywhere, those which differ
+// check the dialect of the Visitor.
+type Dialect int
+
+const (
+	StandardSQL Dialect = iota // SQL
+	PostgreSQL                 // PostgreSQL
+	MySQL                      // MySQL
+	SQLite                     // SQLite
+	SQLServer                  // SQL Server
+	Oracle                     // Oracle
+)
+
+// XQL renders the statement in the dialect.
+func (d Dialect) XQL(a Accepter) string {
+	b := NewDialectBuilder(d)
+	a.Accept(b)
+	return b.String()
+}
+
+// Build renders the statement in the dialect,
+// it fails when the statement is invalid or can't be expressed in the dialect.
+func (d Dialect) Build(a Accepter) (string, error) {
+	b := NewDialectBuilder(d)
+	a.Accept(b)
+	if b.err != nil {
+		return "", b.err
+	}
+	return b.String(), nil
+}
+
+// Is reports whether the dialect is one of x.
+func (d Dialect) Is(x ...Dialect) bool {
+	for _, dd := range x {
+		if d == dd {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (d Dialect) quote() rune {
+	switch d {
+	case StandardSQL, MySQL:
+		return '`'
+	default:
+		return '"'
+	}
+}
+
+// only fails the statement unless it is rendered in the standard dialect or one of the dialects.
+func only(v Visitor, what string, x ...Dialect) {
+	if d := v.Dialect(); d != StandardSQL && !d.Is(x...) {
+		v.Fail(fmt.Errorf("%w: %s", ErrUnsupported, what))
+	}
+}
@@ -0,0 +1,28 @@
+// Code generated by "stringer -type=Dialect -linecomment"; DO NOT EDIT.
+
+package xql
+
+import "strconv"
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[StandardSQL-0]
+	_ = x[PostgreSQL-1]
+	_ = x[MySQL-2]
+	_ = x[SQLite-3]
+	_ = x[SQLServer-4]
+	_ = x[Oracle-5]
+}
+
+const _Dialect_name = "SQLPostgreSQLMySQLSQLiteSQL ServerOracle"
+
+var _Dialect_index = [...]uint8{0, 3, 13, 18, 24, 34, 40}
+
+func (i Dialect) String() string {
+	if i < 0 || i >= Dialect(len(_Dialect_index)-1) {
+		return "Dialect(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _Dialect_name[_Dialect_index[i]:_Dialect_index[i+1]]
+}
@@ -0,0 +1,119 @@
+package xql
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// WithVersion locks the row optimistically with the version column,
+// the struct fields may be tagged with version instead.
+func WithVersion(name ColumnName) StructOption {
+	return applyStructOptionsFunc(func(o *structOptions) { o.version = name })
+}
+
+// lockVersion increments the version of the row, and only updates the row of the expected version.
+func lockVersion(s *UpdateStmt, name ColumnName, expected any) SearchCond {
+	s.Set(Assign(name, Raw(name+" + 1")))
+
+	return Eq(QName(name), expected)
+}
+
+// UpdateDiff updates the columns whose fields changed between the original and the modified struct,
+// the row is identified by the pk fields, or the By columns, of the original struct.
+//
+// It returns ErrNoChanges when no field changed.
+func UpdateDiff[T ToTargetTable](target T, original, modified any, opts ...StructOption) (*UpdateStmt, error) {
+	ov, err := structOf(original)
+	if err != nil {
+		return nil, err
+	}
+
+	mv, err := structOf(modified)
+	if err != nil {
+		return nil, err
+	}
+
+	if ov.Type() != mv.Type() {
+		return nil, fmt.Errorf("xql: can't diff %s with %s", ov.Type(), mv.Type())
+	}
+
+	o := newStructOptions(opts)
+	s := Update(target)
+
+	var conds []SearchCond
+	var version *structField
+
+	for _, f := range structInfoOf(ov.Type()).Fields {
+		switch {
+		case o.key(f):
+			conds = append(conds, Eq(QName(f.Name), f.key(ov)))
+		case f.Version || f.Name == o.version:
+			version = f
+		case f.ReadOnly:
+		default:
+			before, _ := f.value(ov)
+			after, ok := f.value(mv)
+
+			if ok != before.IsValid() || ok && !reflect.DeepEqual(before.Interface(), after.Interface()) {
+				if ok {
+					s.Set(Assign(f.Name, f.literal(after)))
+				} else {
+					s.Set(Assign(f.Name, Nil))
+				}
+			}
+		}
+	}
+
+	if len(s.Sets) == 0 {
+		return nil, ErrNoChanges
+	}
+
+	if version == nil {
+		return o.where(s, conds)
+	}
+
+	return o.where(s, conds, lockVersion(s, version.Name, version.expr(ov)))
+}
+
+// UpdateChanges updates the changed columns of the row identified by the By columns,
+// whose values are taken from the changes, as the expected version is with WithVersion.
+//
+// It returns ErrNoChanges when only the key and the version are in the changes.
+func UpdateChanges[T ToTargetTable](target T, changes map[ColumnName]any, opts ...StructOption) (*UpdateStmt, error) {
+	o := newStructOptions(opts)
+	s := Update(target)
+
+	names := make([]ColumnName, 0, len(changes))
+	for name := range changes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var conds []SearchCond
+
+	for _, name := range names {
+		switch {
+		case o.byColumn(name):
+			conds = append(conds, Eq(QName(name), changes[name]))
+		case name == o.version:
+		default:
+			s.Set(Assign(name, changes[name]))
+		}
+	}
+
+	if len(s.Sets) == 0 {
+		return nil, ErrNoChanges
+	}
+
+	if o.by == nil {
+		return nil, fmt.Errorf("%w to identify the updated row, use By", ErrNoKey)
+	}
+
+	expected, ok := changes[o.version]
+	if o.version == "" || !ok {
+		return o.where(s, conds)
+	}
+
+	return o.where(s, conds, lockVersion(s, o.version, expected))
+}
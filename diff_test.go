@@ -0,0 +1,39 @@
+package xql_test
+
+import (
+	"fmt"
+
+	. "github.com/flier/xql"
+)
+
+type Document struct {
+	ID      int    `xql:"id,pk"`
+	Title   string `xql:"title"`
+	Body    string `xql:"body"`
+	Version int    `xql:"version,version"`
+}
+
+func ExampleUpdateDiff() {
+	original := Document{ID: 7, Title: "Draft", Body: "TODO", Version: 3}
+	modified := original
+	modified.Title = "Final"
+
+	fmt.Println(UpdateDiff("documents", original, modified))
+	fmt.Println(UpdateDiff("documents", original, original))
+	// Output:
+	// UPDATE documents SET title = 'Final', version = version + 1 WHERE id = 7 AND version = 3 <nil>
+	// <nil> xql: no changes
+}
+
+func ExampleUpdateChanges() {
+	fmt.Println(UpdateChanges("documents", map[string]any{
+		"id":      7,
+		"version": 3,
+		"body":    "Done",
+		"title":   "Final",
+	}, By("id"), WithVersion("version")))
+	fmt.Println(UpdateChanges("documents", map[string]any{"title": "Final"}))
+	// Output:
+	// UPDATE documents SET body = 'Done', title = 'Final', version = version + 1 WHERE id = 7 AND version = 3 <nil>
+	// <nil> xql: no key to identify the updated row, use By
+}
@@ -0,0 +1,189 @@
+package xql
+
+import (
+	"fmt"
+)
+
+// DomainDef defines a domain, a data type with a default value and constraints.
+//
+//	<domain definition> ::=
+//		CREATE DOMAIN <domain name> [ AS ] <predefined type>
+//		[ <default clause> ] [ <domain constraint> ... ] [ <collate clause> ]
+//
+// It is rendered as an alias type on SQL Server, which only keeps NOT NULL.
+//
+// https://jakewheat.github.io/sql-overview/sql-2016-foundation-grammar.html#domain-definition
+type DomainDef struct {
+	Name          *SchemaQualifiedName
+	Type          DataType
+	DefaultClause *DefaultClause
+	Constraints   []*ColumnConstraintDef
+	Collate       *CollateClause
+
+	err error // an option of CreateDomain which doesn't apply to a domain
+}
+
+// CreateDomain defines a domain with the default value and the constraints, as they are defined for a column.
+//
+// The domain fails to build with the other column options, such as an identity or a data type.
+func CreateDomain[T ToSchemaQualifiedName](name T, t ToDataType, x ...ColumnDefOption) *DomainDef {
+	c := Column("", x...)
+
+	d := &DomainDef{
+		Name:        SchemaQName(name),
+		Type:        t.dataType(),
+		Constraints: c.Constraints,
+		Collate:     c.Collate,
+	}
+
+	if c.Value != nil {
+		var ok bool
+		if d.DefaultClause, ok = c.Value.(*DefaultClause); !ok {
+			d.err = fmt.Errorf("%w: CREATE DOMAIN with %s", ErrUnsupported, c.Value)
+		}
+	}
+
+	if c.Type != nil {
+		d.err = fmt.Errorf("%w: CREATE DOMAIN with another data type %s", ErrUnsupported, c.Type)
+	}
+
+	return d
+}
+
+// Default sets the default value of the domain.
+func (d *DomainDef) Default(x DefaultOption) *DomainDef {
+	d.DefaultClause = x.AsDefault()
+	return d
+}
+
+// NotNull does not allow null values.
+func (d *DomainDef) NotNull() *DomainDef {
+	d.Constraints = append(d.Constraints, &ColumnConstraintDef{Constraint: NotNull})
+	return d
+}
+
+// Check adds a check constraint to the domain, the value is referred to as VALUE.
+func (d *DomainDef) Check(cond SearchCond) *DomainDef {
+	d.Constraints = append(d.Constraints, &ColumnConstraintDef{Constraint: Check(cond)})
+	return d
+}
+
+const (
+	kCreateDomain = Keyword("CREATE DOMAIN")
+	kCreateType   = Keyword("CREATE TYPE")
+)
+
+func (d *DomainDef) Accept(v Visitor) Visitor {
+	if d.err != nil {
+		return v.Fail(d.err)
+	}
+
+	dataType := AcceptFunc(func(v Visitor) Visitor { return v.DataType(d.Type) })
+
+	if v.Dialect() == SQLServer {
+		if d.DefaultClause != nil || d.Collate != nil {
+			v.Fail(fmt.Errorf("%w: CREATE DOMAIN with a default value or a collation", ErrUnsupported))
+		}
+
+		notNull := false
+
+		for _, c := range d.Constraints {
+			if c.Constraint != NotNull || c.Name != nil {
+				v.Fail(fmt.Errorf("%w: CREATE DOMAIN with constraints", ErrUnsupported))
+			}
+
+			notNull = true
+		}
+
+		return v.Visit(kCreateType, WS, d.Name, WS, kFrom, WS, dataType).If(notNull, WS, kNotNull)
+	}
+
+	only(v, "CREATE DOMAIN", PostgreSQL)
+
+	return v.Visit(kCreateDomain, WS, d.Name, WS, kAs, WS, dataType).
+		IfNotNil(d.DefaultClause, WS, d.DefaultClause).
+		IfNotNil(d.Constraints, WS, Joins(d.Constraints, WS)).
+		IfNotNil(d.Collate, WS, d.Collate)
+}
+
+func (d *DomainDef) String() string { return XQL(d) }
+
+// AlterDomainStmt changes the definition of a domain.
+//
+//	<alter domain statement> ::= ALTER DOMAIN <domain name> <alter domain action>
+//
+// Each action is rendered as its own statement, separated by ";\n".
+//
+// https://jakewheat.github.io/sql-overview/sql-2016-foundation-grammar.html#alter-domain-statement
+type AlterDomainStmt struct {
+	Name    *SchemaQualifiedName
+	Actions []Accepter
+}
+
+// AlterDomain changes the definition of a domain.
+func AlterDomain[T ToSchemaQualifiedName](name T) *AlterDomainStmt {
+	return &AlterDomainStmt{Name: SchemaQName(name)}
+}
+
+func (s *AlterDomainStmt) action(x Accepter) *AlterDomainStmt {
+	s.Actions = append(s.Actions, x)
+	return s
+}
+
+// SetDefault sets the default value of the domain.
+func (s *AlterDomainStmt) SetDefault(x DefaultOption) *AlterDomainStmt {
+	return s.action(AcceptFunc(func(v Visitor) Visitor { return v.Visit(kSet, WS, x.AsDefault()) }))
+}
+
+// DropDefault drops the default value of the domain.
+func (s *AlterDomainStmt) DropDefault() *AlterDomainStmt { return s.action(kDropDefault) }
+
+// SetNotNull does not allow null values.
+func (s *AlterDomainStmt) SetNotNull() *AlterDomainStmt { return s.action(kSetNotNull) }
+
+// DropNotNull allows null values.
+func (s *AlterDomainStmt) DropNotNull() *AlterDomainStmt { return s.action(kDropNotNull) }
+
+// AddConstraint adds a constraint to the domain.
+func (s *AlterDomainStmt) AddConstraint(c ToTableConstraintDef) *AlterDomainStmt {
+	return s.action(&AddConstraintAction{Constraint: c.tableConstraintDef()})
+}
+
+// AddConstraintNotValid adds a constraint to the domain without checking the existing values.
+func (s *AlterDomainStmt) AddConstraintNotValid(c ToTableConstraintDef) *AlterDomainStmt {
+	return s.action(&AddConstraintAction{c.tableConstraintDef(), true})
+}
+
+// ValidateConstraint checks the existing values against a constraint added without checking them.
+func (s *AlterDomainStmt) ValidateConstraint(name string) *AlterDomainStmt {
+	return s.action(&ValidateConstraintAction{constraintName(name)})
+}
+
+// DropConstraint drops a constraint of the domain.
+func (s *AlterDomainStmt) DropConstraint(name string, x ...DropBehavior) *AlterDomainStmt {
+	return s.action(&DropConstraintAction{constraintName(name), dropBehavior(x)})
+}
+
+// RenameConstraint renames a constraint of the domain.
+func (s *AlterDomainStmt) RenameConstraint(name, to string) *AlterDomainStmt {
+	return s.action(&RenameConstraintAction{constraintName(name), to})
+}
+
+// RenameTo renames the domain.
+func (s *AlterDomainStmt) RenameTo(name string) *AlterDomainStmt {
+	return s.action(&RenameTableAction{name})
+}
+
+const kAlterDomain = Keyword("ALTER DOMAIN")
+
+func (s *AlterDomainStmt) Accept(v Visitor) Visitor {
+	only(v, "ALTER DOMAIN", PostgreSQL)
+
+	for i, a := range s.Actions {
+		v = v.If(i > 0, Raw(";\n")).Visit(kAlterDomain, WS, s.Name, WS, a)
+	}
+
+	return v
+}
+
+func (s *AlterDomainStmt) String() string { return XQL(s) }
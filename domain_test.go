@@ -0,0 +1,42 @@
+package xql_test
+
+import (
+	"fmt"
+
+	. "github.com/flier/xql"
+)
+
+func ExampleCreateDomain() {
+	fmt.Println(CreateDomain("us_postal_code", Text,
+		Constraint("us_postal_code_check").Check(Raw("VALUE ~ '^\\d{5}$'"))))
+	fmt.Println(CreateDomain("positive_int", Integer, Literal("1").AsDefault(), NotNull, Check(Raw("VALUE > 0"))))
+	fmt.Println(SQLServer.XQL(CreateDomain("ssn", VarChar(11)).NotNull()))
+
+	_, err := SQLServer.Build(CreateDomain("positive_int", Integer).Check(Raw("VALUE > 0")))
+	fmt.Println(err)
+	_, err = PostgreSQL.Build(CreateDomain("serial_int", Integer, Generated.Always().AsIdentity()))
+	fmt.Println(err)
+	// Output:
+	// CREATE DOMAIN us_postal_code AS TEXT CONSTRAINT us_postal_code_check CHECK (VALUE ~ '^\d{5}$')
+	// CREATE DOMAIN positive_int AS INTEGER DEFAULT 1 NOT NULL CHECK (VALUE > 0)
+	// CREATE TYPE ssn FROM VARCHAR(11) NOT NULL
+	// xql: unsupported by the dialect: CREATE DOMAIN with constraints
+	// xql: unsupported by the dialect: CREATE DOMAIN with GENERATED ALWAYS AS IDENTITY
+}
+
+func ExampleAlterDomain() {
+	fmt.Println(PostgreSQL.XQL(AlterDomain("zipcode").
+		SetNotNull().
+		DropDefault().
+		AddConstraint(Constraint("zipchk").Check(Raw("char_length(VALUE) = 5"))).
+		RenameConstraint("zipchk", "zip_check").
+		DropConstraint("zip_check", DropCascade).
+		RenameTo("zip")))
+	// Output:
+	// ALTER DOMAIN zipcode SET NOT NULL;
+	// ALTER DOMAIN zipcode DROP DEFAULT;
+	// ALTER DOMAIN zipcode ADD CONSTRAINT zipchk CHECK (char_length(VALUE) = 5);
+	// ALTER DOMAIN zipcode RENAME CONSTRAINT zipchk TO zip_check;
+	// ALTER DOMAIN zipcode DROP CONSTRAINT zip_check CASCADE;
+	// ALTER DOMAIN zipcode RENAME TO zip
+}
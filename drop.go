@@ -0,0 +1,196 @@
+package xql
+
+import (
+	"fmt"
+)
+
+// DropStmt destroys one or more schema objects.
+//
+//	<drop table statement> ::= DROP TABLE <table name> <drop behavior>
+//	<drop view statement> ::= DROP VIEW <table name> <drop behavior>
+//	<drop schema statement> ::= DROP SCHEMA <schema name> <drop behavior>
+//	<drop domain statement> ::= DROP DOMAIN <domain name> <drop behavior>
+//	<drop data type statement> ::= DROP TYPE <schema-resolved user-defined type name> <drop behavior>
+//	<drop sequence generator statement> ::= DROP SEQUENCE <sequence generator name> <drop behavior>
+//
+// The standard drops one object per statement, the dialects that only allow one object per statement
+// get one statement per name, separated by ";\n".
+//
+// https://jakewheat.github.io/sql-overview/sql-2016-foundation-grammar.html#drop-table-statement
+type DropStmt struct {
+	Object      Keyword
+	SkipMissing bool
+	Names       []*LocalOrSchemaQualifiedName
+	Table       *TableName
+	Drop        *DropBehavior
+}
+
+const (
+	kView     = Keyword("VIEW")
+	kSchema   = Keyword("SCHEMA")
+	kDomain   = Keyword("DOMAIN")
+	kType     = Keyword("TYPE")
+	kSequence = Keyword("SEQUENCE")
+	kIndex    = Keyword("INDEX")
+
+	kMaterializedView = Keyword("MATERIALIZED VIEW")
+)
+
+func drop[T ToLocalOrSchemaQualifiedName](object Keyword, x []T) *DropStmt {
+	s := &DropStmt{Object: object}
+
+	for _, name := range x {
+		s.Names = append(s.Names, LocalOrSchemaQName(name))
+	}
+
+	return s
+}
+
+// DropTable destroys one or more tables.
+func DropTable[T ToTableName](x ...T) *DropStmt { return drop(kTable, x) }
+
+// DropView destroys one or more views.
+func DropView[T ToTableName](x ...T) *DropStmt { return drop(kView, x) }
+
+// DropMaterializedView destroys one or more materialized views.
+func DropMaterializedView[T ToTableName](x ...T) *DropStmt { return drop(kMaterializedView, x) }
+
+// DropSchema destroys one or more schemas.
+func DropSchema(x ...string) *DropStmt { return drop(kSchema, x) }
+
+// DropDomain destroys one or more domains.
+func DropDomain[T ToSchemaQualifiedName](x ...T) *DropStmt { return drop(kDomain, x) }
+
+// DropType destroys one or more user-defined types.
+func DropType[T ToSchemaQualifiedName](x ...T) *DropStmt { return drop(kType, x) }
+
+// DropSequence destroys one or more sequence generators.
+func DropSequence[T ToSchemaQualifiedName](x ...T) *DropStmt { return drop(kSequence, x) }
+
+// DropIndex destroys one or more indexes.
+func DropIndex[T ToSchemaQualifiedName](x ...T) *DropStmt { return drop(kIndex, x) }
+
+// IfExists does not fail if the object does not exist,
+// it requires Oracle 23c, and isn't supported for the indexes on MySQL.
+func (s *DropStmt) IfExists() *DropStmt {
+	s.SkipMissing = true
+	return s
+}
+
+// On sets the table of the indexes, MySQL and SQL Server require it to drop an index.
+func (s *DropStmt) On(table string) *DropStmt {
+	s.Table = newTableName(table)
+	return s
+}
+
+// Cascade automatically drops the objects that depend on the dropped objects.
+func (s *DropStmt) Cascade() *DropStmt {
+	b := DropCascade
+	s.Drop = &b
+	return s
+}
+
+// Restrict refuses to drop the objects if any objects depend on them.
+func (s *DropStmt) Restrict() *DropStmt {
+	b := DropRestrict
+	s.Drop = &b
+	return s
+}
+
+// Stmts splits the statement into one statement per object when the dialect only allows one object per statement.
+func (s *DropStmt) Stmts(d Dialect) []*DropStmt {
+	if len(s.Names) < 2 || s.manyAtOnce(d) {
+		return []*DropStmt{s}
+	}
+
+	stmts := make([]*DropStmt, len(s.Names))
+
+	for i, name := range s.Names {
+		stmt := *s
+		stmt.Names = []*LocalOrSchemaQualifiedName{name}
+		stmts[i] = &stmt
+	}
+
+	return stmts
+}
+
+func (s *DropStmt) manyAtOnce(d Dialect) bool {
+	switch d {
+	case PostgreSQL:
+		return true
+	case MySQL:
+		return s.Object == kTable || s.Object == kView
+	case SQLServer:
+		return s.Object == kTable || s.Object == kView || s.Object == kSequence || s.Object == kIndex
+	default:
+		return false
+	}
+}
+
+const kForce = Keyword("FORCE")
+
+func (s *DropStmt) Accept(v Visitor) Visitor {
+	for i, stmt := range s.Stmts(v.Dialect()) {
+		v = v.If(i > 0, Raw(";\n")).Visit(AcceptFunc(stmt.accept))
+	}
+
+	return v
+}
+
+func (s *DropStmt) accept(v Visitor) Visitor {
+	what := kDrop.String() + " " + s.Object.String()
+
+	if len(s.Names) == 0 {
+		return v.Fail(fmt.Errorf("%w: %s without names", ErrIncomplete, what))
+	}
+
+	switch s.Object {
+	case kSchema:
+		only(v, what, PostgreSQL, MySQL, SQLServer)
+	case kDomain:
+		only(v, what, PostgreSQL)
+	case kMaterializedView:
+		only(v, what, PostgreSQL, Oracle)
+	case kType, kSequence:
+		only(v, what, PostgreSQL, SQLServer, Oracle)
+	case kIndex:
+		if s.Table == nil && v.Dialect().Is(MySQL, SQLServer) {
+			v.Fail(fmt.Errorf("%w: %s without the table", ErrUnsupported, what))
+		}
+	}
+
+	if s.SkipMissing && v.Dialect() == MySQL && s.Object == kIndex {
+		v.Fail(fmt.Errorf("%w: %s IF EXISTS", ErrUnsupported, what))
+	}
+
+	names := make([]Accepter, len(s.Names))
+
+	for i, name := range s.Names {
+		name := name
+
+		if s.Object == kIndex && s.Table != nil && v.Dialect().Is(MySQL, SQLServer) {
+			names[i] = AcceptFunc(func(v Visitor) Visitor { return v.Visit(name, WS, kOn, WS, s.Table) })
+		} else {
+			names[i] = name
+		}
+	}
+
+	v.Visit(kDrop, WS, s.Object).If(s.SkipMissing, WS, kIfExists).Visit(WS, Joins(names, Sep))
+
+	if s.Drop == nil {
+		return v
+	}
+
+	switch {
+	case v.Dialect() == Oracle && *s.Drop == DropCascade && s.Object == kTable:
+		return v.Visit(WS, kCascadeConstraints)
+	case v.Dialect() == Oracle && *s.Drop == DropCascade && s.Object == kType:
+		return v.Visit(WS, kForce)
+	case v.Dialect() == MySQL && (s.Object == kTable || s.Object == kView):
+		return v.Visit(WS, Stringer(s.Drop))
+	default:
+		return acceptDropBehavior(v, what, s.Drop, PostgreSQL)
+	}
+}
+
+func (s *DropStmt) String() string { return XQL(s) }
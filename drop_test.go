@@ -0,0 +1,65 @@
+package xql_test
+
+import (
+	"fmt"
+
+	. "github.com/flier/xql"
+)
+
+func ExampleDropTable() {
+	drop := DropTable("films", "distributors").IfExists().Cascade()
+
+	for _, d := range []Dialect{StandardSQL, PostgreSQL, MySQL, SQLite, SQLServer, Oracle} {
+		s, err := d.Build(drop)
+		fmt.Printf("%s: %s %v\n", d, s, err)
+	}
+	// Output:
+	// SQL: DROP TABLE IF EXISTS films CASCADE;
+	// DROP TABLE IF EXISTS distributors CASCADE <nil>
+	// PostgreSQL: DROP TABLE IF EXISTS films, distributors CASCADE <nil>
+	// MySQL: DROP TABLE IF EXISTS films, distributors CASCADE <nil>
+	// SQLite:  xql: unsupported by the dialect: DROP TABLE CASCADE
+	// SQL Server:  xql: unsupported by the dialect: DROP TABLE CASCADE
+	// Oracle: DROP TABLE IF EXISTS films CASCADE CONSTRAINTS;
+	// DROP TABLE IF EXISTS distributors CASCADE CONSTRAINTS <nil>
+}
+
+func ExampleDropIndex() {
+	drop := DropIndex("title_idx", "kind_idx").On("films")
+
+	for _, d := range []Dialect{PostgreSQL, MySQL, SQLite, SQLServer} {
+		fmt.Printf("%s: %s\n", d, d.XQL(drop))
+	}
+
+	_, err := MySQL.Build(DropIndex("title_idx"))
+	fmt.Println(err)
+	// Output:
+	// PostgreSQL: DROP INDEX title_idx, kind_idx
+	// MySQL: DROP INDEX title_idx ON films;
+	// DROP INDEX kind_idx ON films
+	// SQLite: DROP INDEX title_idx;
+	// DROP INDEX kind_idx
+	// SQL Server: DROP INDEX title_idx ON films, kind_idx ON films
+	// xql: unsupported by the dialect: DROP INDEX without the table
+}
+
+func ExampleDropType() {
+	fmt.Println(DropView("kinds").Restrict())
+	fmt.Println(DropSchema("mystuff").IfExists())
+	fmt.Println(DropDomain("us_postal_code"))
+	fmt.Println(PostgreSQL.XQL(DropType("box", "point").Cascade()))
+	fmt.Println(Oracle.XQL(DropType("person_t").Cascade()))
+
+	_, err := MySQL.Build(DropDomain("us_postal_code"))
+	fmt.Println(err)
+	_, err = Build(DropType[string]())
+	fmt.Println(err)
+	// Output:
+	// DROP VIEW kinds RESTRICT
+	// DROP SCHEMA IF EXISTS mystuff
+	// DROP DOMAIN us_postal_code
+	// DROP TYPE box, point CASCADE
+	// DROP TYPE person_t FORCE
+	// xql: unsupported by the dialect: DROP DOMAIN
+	// xql: incomplete statement: DROP TYPE without names
+}
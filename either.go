@@ -18,8 +18,22 @@ func Right[L fmt.Stringer, R fmt.Stringer](right R) Either[L, R] {
 	return Either[L, R]{Right: right}
 }
 
+func (e *Either[L, R]) isLeft() bool {
+	v := reflect.ValueOf(e.Left)
+
+	return v.IsValid() && !v.IsZero()
+}
+
+func (e *Either[L, R]) Accept(v Visitor) Visitor {
+	if e.isLeft() {
+		return v.Visit(accept(e.Left))
+	}
+
+	return v.Visit(accept(e.Right))
+}
+
 func (e *Either[L, R]) String() string {
-	if !reflect.ValueOf(e.Left).IsZero() {
+	if e.isLeft() {
 		return e.Left.String()
 	}
 
@@ -0,0 +1,20 @@
+package xql
+
+import "errors"
+
+var (
+	// ErrColumnCount is returned when the number of values doesn't match the number of columns.
+	ErrColumnCount = errors.New("xql: column count mismatch")
+
+	// ErrNoKey is returned when a struct or a change map has no key to identify its row.
+	ErrNoKey = errors.New("xql: no key")
+
+	// ErrNoChanges is returned instead of a statement which would change nothing.
+	ErrNoChanges = errors.New("xql: no changes")
+
+	// ErrIncomplete is returned when a required part of the statement is missing.
+	ErrIncomplete = errors.New("xql: incomplete statement")
+
+	// ErrUnsupported is returned when the statement can't be expressed in the dialect.
+	ErrUnsupported = errors.New("xql: unsupported by the dialect")
+)
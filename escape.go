@@ -55,3 +55,8 @@ func Quote(name string, quote rune, needQuote bool) string {
 
 	return name
 }
+
+// quoteLiteral quotes the string as a character string literal.
+func quoteLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
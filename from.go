@@ -1,7 +1,5 @@
 package xql
 
-import "fmt"
-
 type FromClause TableRefList
 
 func From(x ...ToTableRef) FromClause {
@@ -14,4 +12,7 @@ func From(x ...ToTableRef) FromClause {
 	return FromClause(refs)
 }
 
-func (c FromClause) String() string { return fmt.Sprintf("FROM %s", TableRefList(c)) }
+const kFrom = Keyword("FROM")
+
+func (c FromClause) Accept(v Visitor) Visitor { return v.Visit(kFrom, WS, TableRefList(c)) }
+func (c FromClause) String() string           { return XQL(c) }
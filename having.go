@@ -1,10 +1,12 @@
 package xql
 
-import "fmt"
-
 type HavingClause struct {
 	Search SearchCond
 }
 
+const kHaving = Keyword("HAVING")
+
 func Having(cond SearchCond) *HavingClause { return &HavingClause{cond} }
-func (h *HavingClause) String() string     { return fmt.Sprintf("HAVING %s", h.Search) }
+
+func (h *HavingClause) Accept(v Visitor) Visitor { return v.Visit(kHaving, WS, accept(h.Search)) }
+func (h *HavingClause) String() string           { return XQL(h) }
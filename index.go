@@ -0,0 +1,222 @@
+package xql
+
+import (
+	"fmt"
+	"strings"
+)
+
+//go:generate stringer -type IndexMethod -linecomment
+
+// IndexMethod is the access method of an index.
+type IndexMethod int
+
+const (
+	IndexBTree  IndexMethod = iota // btree
+	IndexHash                      // hash
+	IndexGin                       // gin
+	IndexGist                      // gist
+	IndexSpGist                    // spgist
+	IndexBrin                      // brin
+)
+
+// StorageParam is a storage parameter of an index, such as fillfactor.
+//
+// A string value is quoted, a Raw or a keyword is rendered as is, e.g. an identifier.
+type StorageParam struct {
+	Name  string
+	Value any
+}
+
+func (p *StorageParam) Accept(v Visitor) Visitor {
+	v.Raw(p.Name).Visit(WS, Token('='), WS)
+
+	switch x := p.Value.(type) {
+	case DataType:
+		return v.DataType(x)
+	case Accepter:
+		return v.Visit(x)
+	default:
+		return v.Visit(accept(newTypedRowValueExpr(x)))
+	}
+}
+
+func (p *StorageParam) String() string { return XQL(p) }
+
+// IndexDef defines an index on a table.
+//
+//	CREATE [ UNIQUE ] INDEX [ CONCURRENTLY ] [ IF NOT EXISTS ] <index name>
+//		ON <table name> [ USING <method> ] ( <sort specification> [, ...] )
+//		[ INCLUDE ( <column name> [, ...] ) ]
+//		[ WITH ( <storage parameter> = <value> [, ...] ) ]
+//		[ WHERE <predicate> ]
+//
+// Indexes are not part of the standard, the syntax follows PostgreSQL.
+// Each dialect drops the options it has no use for and fails on the ones it doesn't support.
+type IndexDef struct {
+	Name         string
+	UniqueKeys   bool
+	Concurrent   bool
+	SkipExisting bool
+	Table        *TableName
+	Method       *IndexMethod
+	Keys         SortSpecList
+	Included     ColumnNameList
+	Params       []*StorageParam
+	Cond         SearchCond
+}
+
+// CreateIndex defines an index.
+func CreateIndex(name string) *IndexDef {
+	return &IndexDef{Name: name}
+}
+
+// CreateUniqueIndex defines an index that does not allow duplicate keys.
+func CreateUniqueIndex(name string) *IndexDef {
+	return &IndexDef{Name: name, UniqueKeys: true}
+}
+
+// On sets the indexed table and the keys of the index, a key is a column or an expression.
+func (d *IndexDef) On(table string, keys ...ToSortSpec) *IndexDef {
+	d.Table = newTableName(table)
+
+	for _, k := range keys {
+		d.Keys = append(d.Keys, k.sortSpec())
+	}
+
+	return d
+}
+
+// Unique does not allow duplicate keys.
+func (d *IndexDef) Unique() *IndexDef {
+	d.UniqueKeys = true
+	return d
+}
+
+// Concurrently builds the index without locking out writes on the table.
+func (d *IndexDef) Concurrently() *IndexDef {
+	d.Concurrent = true
+	return d
+}
+
+// IfNotExists does not fail if an index with the same name already exists.
+func (d *IndexDef) IfNotExists() *IndexDef {
+	d.SkipExisting = true
+	return d
+}
+
+// Using sets the access method of the index.
+func (d *IndexDef) Using(m IndexMethod) *IndexDef {
+	d.Method = &m
+	return d
+}
+
+// Include adds non-key columns to the index, so that index-only scans can return them.
+func (d *IndexDef) Include(x ...ColumnName) *IndexDef {
+	d.Included = append(d.Included, x...)
+	return d
+}
+
+// With sets a storage parameter of the index.
+func (d *IndexDef) With(name string, value any) *IndexDef {
+	d.Params = append(d.Params, &StorageParam{name, value})
+	return d
+}
+
+// Where builds a partial index on the rows that satisfy the predicate.
+func (d *IndexDef) Where(cond SearchCond) *IndexDef {
+	d.Cond = cond
+	return d
+}
+
+const (
+	kCreateIndex       = Keyword("CREATE INDEX")
+	kCreateUniqueIndex = Keyword("CREATE UNIQUE INDEX")
+	kConcurrently      = Keyword("CONCURRENTLY")
+	kIfNotExists       = Keyword("IF NOT EXISTS")
+	kInclude           = Keyword("INCLUDE")
+	kOnline            = Keyword("ONLINE")
+)
+
+func (d *IndexDef) Accept(v Visitor) Visitor {
+	dialect := v.Dialect()
+
+	if d.Table == nil || len(d.Keys) == 0 {
+		return v.Fail(fmt.Errorf("%w: CREATE INDEX without the table or the keys", ErrIncomplete))
+	}
+
+	if d.SkipExisting {
+		only(v, "CREATE INDEX IF NOT EXISTS", PostgreSQL, SQLite, Oracle)
+	}
+	if d.Method != nil && *d.Method != IndexBTree && !(*d.Method == IndexHash && dialect == MySQL) {
+		only(v, "CREATE INDEX USING "+d.Method.String(), PostgreSQL)
+	}
+	if d.Included != nil {
+		only(v, "CREATE INDEX INCLUDE", PostgreSQL, SQLServer)
+	}
+	if d.Params != nil {
+		only(v, "CREATE INDEX WITH", PostgreSQL, SQLServer)
+	}
+	if d.Cond != nil {
+		only(v, "CREATE INDEX WHERE", PostgreSQL, SQLite, SQLServer)
+	}
+
+	v.IfElse(d.UniqueKeys, kCreateUniqueIndex, kCreateIndex).
+		If(d.Concurrent && dialect.Is(StandardSQL, PostgreSQL), WS, kConcurrently).
+		If(d.SkipExisting, WS, kIfNotExists).
+		Visit(WS, Ident(Raw(d.Name)), WS, kOn, WS, d.Table)
+
+	if d.Method != nil && dialect.Is(StandardSQL, PostgreSQL) {
+		v.Visit(WS, kUsing, WS, Keyword(d.Method.String()))
+	}
+
+	keys := make([]Accepter, len(d.Keys))
+	for i, k := range d.Keys {
+		keys[i] = &indexKey{k}
+	}
+
+	v.Visit(WS, Paren(Joins(keys, Sep))).
+		IfNotNil(d.Included, WS, kInclude, WS, d.Included)
+
+	if d.Method != nil && dialect == MySQL {
+		// MySQL takes the index type as an index option after the key parts.
+		v.Visit(WS, kUsing, WS, Keyword(strings.ToUpper(d.Method.String())))
+	}
+
+	params := d.Params
+	if d.Concurrent && dialect == SQLServer {
+		params = append(params[:len(params):len(params)], &StorageParam{"ONLINE", kOn})
+	}
+
+	return v.IfNotNil(params, WS, kWith, WS, Paren(Joins(params, Sep))).
+		IfNotNil(d.Cond, WS, kWhere, WS, accept(d.Cond)).
+		If(d.Concurrent && dialect == Oracle, WS, kOnline)
+}
+
+func (d *IndexDef) String() string { return XQL(d) }
+
+// indexKey renders a key of an index, an expression is parenthesized unless it is a plain column.
+type indexKey struct {
+	*SortSpec
+}
+
+func (k *indexKey) Accept(v Visitor) Visitor {
+	if _, ok := unqualifiedColumn(k.Key); ok {
+		v.Visit(accept(k.Key))
+	} else {
+		switch v.Dialect() {
+		case SQLServer:
+			v.Fail(fmt.Errorf("%w: CREATE INDEX on an expression", ErrUnsupported))
+		case Oracle:
+			v.Visit(accept(k.Key))
+		default:
+			v.Visit(Paren(accept(k.Key)))
+		}
+	}
+
+	if k.NullOrdering != NullsDefault {
+		only(v, "CREATE INDEX ... "+k.NullOrdering.String(), PostgreSQL, Oracle)
+	}
+
+	return v.If(k.OrderingSpec != OrderingAsc, WS, Keyword(k.OrderingSpec.String())).
+		If(k.NullOrdering != NullsDefault, WS, Keyword(k.NullOrdering.String()))
+}
@@ -0,0 +1,51 @@
+package xql_test
+
+import (
+	"fmt"
+
+	. "github.com/flier/xql"
+)
+
+func ExampleCreateIndex() {
+	fmt.Println(CreateUniqueIndex("title_idx").On("films", Column("title")).Include("director", "rating"))
+	fmt.Println(CreateIndex("lower_title_idx").On("films", Raw("lower(title)")))
+	fmt.Println(CreateIndex("title_idx_nulls_low").On("films", Desc(Raw("title")).NullsLast()))
+	fmt.Println(CreateIndex("gin_idx").On("documents_table", Column("locations")).Using(IndexGin).With("fastupdate", false))
+	fmt.Println(CreateIndex("title_idx_nulls_first").On("films", Asc(Raw("title")).NullsFirst()).With("fillfactor", 70))
+	fmt.Println(CreateIndex("code_idx").IfNotExists().Concurrently().On("films", Column("code")).
+		Where(Gt(Column("code"), 100)))
+
+	_, err := Build(CreateIndex("code_idx").On("films"))
+	fmt.Println(err)
+	// Output:
+	// CREATE UNIQUE INDEX title_idx ON films (title) INCLUDE (director, rating)
+	// CREATE INDEX lower_title_idx ON films ((lower(title)))
+	// CREATE INDEX title_idx_nulls_low ON films (title DESC NULLS LAST)
+	// CREATE INDEX gin_idx ON documents_table USING gin (locations) WITH (fastupdate = false)
+	// CREATE INDEX title_idx_nulls_first ON films (title NULLS FIRST) WITH (fillfactor = 70)
+	// CREATE INDEX CONCURRENTLY IF NOT EXISTS code_idx ON films (code) WHERE code > 100
+	// xql: incomplete statement: CREATE INDEX without the table or the keys
+}
+
+func ExampleIndexDef_Concurrently() {
+	idx := CreateIndex("code_idx").Concurrently().Using(IndexBTree).On("films", Column("code"), Desc(Raw("len")))
+
+	for _, d := range []Dialect{PostgreSQL, MySQL, SQLite, SQLServer, Oracle} {
+		fmt.Printf("%s: %s\n", d, d.XQL(idx))
+	}
+
+	for _, d := range []Dialect{MySQL, SQLite, SQLServer, Oracle} {
+		_, err := d.Build(CreateIndex("gist_idx").On("shapes", Column("box")).Using(IndexGist))
+		fmt.Printf("%s: %v\n", d, err)
+	}
+	// Output:
+	// PostgreSQL: CREATE INDEX CONCURRENTLY code_idx ON films USING btree (code, len DESC)
+	// MySQL: CREATE INDEX code_idx ON films (code, len DESC) USING BTREE
+	// SQLite: CREATE INDEX code_idx ON films (code, len DESC)
+	// SQL Server: CREATE INDEX code_idx ON films (code, len DESC) WITH (ONLINE = ON)
+	// Oracle: CREATE INDEX code_idx ON films (code, len DESC) ONLINE
+	// MySQL: xql: unsupported by the dialect: CREATE INDEX USING gist
+	// SQLite: xql: unsupported by the dialect: CREATE INDEX USING gist
+	// SQL Server: xql: unsupported by the dialect: CREATE INDEX USING gist
+	// Oracle: xql: unsupported by the dialect: CREATE INDEX USING gist
+}
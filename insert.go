@@ -2,22 +2,120 @@ package xql
 
 import (
 	"fmt"
-	"strings"
 )
 
 type InsertStmt struct {
 	Target *TableName
 	From   InsertFrom
+	Upsert *OnConflictClause
+	Return *ReturningClause
 }
 
-func InsertInto[T ToLocalOrSchemaQualifiedName](name T, from InsertFrom) *InsertStmt {
-	return &InsertStmt{newTableName(name), from}
+// InsertInto inserts rows into the table,
+// they come from the optional from or are set later with Columns, Values or Select.
+func InsertInto[T ToLocalOrSchemaQualifiedName](name T, from ...InsertFrom) *InsertStmt {
+	s := &InsertStmt{Target: newTableName(name)}
+	if len(from) > 0 {
+		s.From = from[0]
+	}
+	return s
+}
+
+// Returning returns the columns of the inserted rows.
+func (i *InsertStmt) Returning(x ...SelectFieldOrAsterisk) *InsertStmt {
+	i.Return = Returning(x...)
+	return i
+}
+
+// ReturningInto stores the returned columns into the variables, as required by Oracle,
+// the columns are given by Returning.
+func (i *InsertStmt) ReturningInto(vars ...VarName) *InsertStmt {
+	if i.Return == nil {
+		i.Return = &ReturningClause{}
+	}
+
+	i.Return.Into = vars
+	return i
+}
+
+// Columns names the inserted columns.
+func (i *InsertStmt) Columns(x ...ColumnName) *InsertColumnsStep {
+	return &InsertColumnsStep{i, x, nil}
+}
+
+// Values inserts rows of values for every column of the table.
+func (i *InsertStmt) Values(x ...any) *InsertStmt {
+	i.From = Values(x...)
+	return i
+}
+
+// Select inserts the rows returned by the query.
+func (i *InsertStmt) Select(q SelectQuery) *InsertStmt {
+	i.From = &FromSubQuery{SubQuery: q.Query()}
+	return i
+}
+
+type InsertColumnsStep struct {
+	s          *InsertStmt
+	columns    ColumnNameList
+	overriding *OverridingClause
 }
 
-func (i *InsertStmt) String() string {
-	return fmt.Sprintf("INSERT INTO %s %s", i.Target, i.From)
+func (c *InsertColumnsStep) OverridingSystemValue() *InsertColumnsStep {
+	o := OverridingSystemValue
+	c.overriding = &o
+	return c
+}
+
+func (c *InsertColumnsStep) OverridingUserValue() *InsertColumnsStep {
+	o := OverridingUserValue
+	c.overriding = &o
+	return c
+}
+
+func (c *InsertColumnsStep) Values(x ...any) *InsertStmt {
+	f := Columns(c.columns...).Values(x...)
+	f.Overriding = c.overriding
+	c.s.From = f
+	return c.s
+}
+
+func (c *InsertColumnsStep) Select(q SelectQuery) *InsertStmt {
+	c.s.From = &FromSubQuery{c.columns, c.overriding, q.Query()}
+	return c.s
+}
+
+const kInsertInto = Keyword("INSERT INTO")
+
+func (i *InsertStmt) Accept(v Visitor) Visitor {
+	if i.Upsert != nil && v.Dialect().Is(SQLServer, Oracle) {
+		m, err := i.merge(v.Dialect())
+		if err != nil {
+			return v.Fail(err)
+		}
+
+		return v.Visit(m)
+	}
+
+	if i.Return != nil && v.Dialect() == SQLServer {
+		v.Visit(kInsertInto, WS, i.Target, WS)
+
+		if f, ok := i.From.(interface {
+			acceptOutput(v Visitor, output Accepter) Visitor
+		}); ok {
+			return f.acceptOutput(v, i.Return.output(kInserted))
+		}
+
+		return v.Visit(i.Return.output(kInserted), WS, accept(i.From))
+	}
+
+	return v.Visit(kInsertInto, WS, i.Target, WS, accept(i.From)).
+		IfNotNil(i.Upsert, WS, i.Upsert).
+		IfNotNil(i.Return, WS, i.Return)
 }
 
+func (i *InsertStmt) String() string { return XQL(i) }
+
 type ToInsertFrom interface {
 	insertFrom() InsertFrom
 }
@@ -40,30 +138,56 @@ var (
 type OverridingClause int
 
 const (
-	OverridingUserValue OverridingClause = iota
-	OverridingSystemValue
+	OverridingUserValue   OverridingClause = iota // OVERRIDING USER VALUE
+	OverridingSystemValue                         // OVERRIDING SYSTEM VALUE
 )
 
 type FromSubQuery struct {
 	Columns    ColumnNameList
 	Overriding *OverridingClause
-	SubQuery   QueryExpr
+	SubQuery   QueryExprBody
 }
 
 func (f *FromSubQuery) insertFrom() InsertFrom { return f }
 
-func (f *FromSubQuery) String() string {
-	var b strings.Builder
+func (f *FromSubQuery) Accept(v Visitor) Visitor { return f.acceptOutput(v, nil) }
 
-	if len(f.Columns) > 0 {
-		fmt.Fprintf(&b, "%s ", f.Columns)
+// acceptOutput renders the OUTPUT clause of SQL Server between the columns and the query.
+func (f *FromSubQuery) acceptOutput(v Visitor, output Accepter) Visitor {
+	if n, ok := selectListLen(f.SubQuery); ok && len(f.Columns) > 0 && n != len(f.Columns) {
+		v.Fail(fmt.Errorf("%w: %d columns, %d selected", ErrColumnCount, len(f.Columns), n))
 	}
-	if f.Overriding != nil {
-		fmt.Fprintf(&b, "%s ", f.Overriding)
+
+	return v.IfNotNil(f.Columns, f.Columns, WS).
+		IfNotNil(f.Overriding, Stringer(f.Overriding), WS).
+		IfNotNil(output, output, WS).
+		Visit(accept(f.SubQuery))
+}
+
+func (f *FromSubQuery) String() string { return XQL(f) }
+
+// selectListLen returns the number of columns selected by the query when it is known,
+// a raw item may select any number of columns, e.g. t.* or a, b.
+func selectListLen(q QueryExprBody) (int, bool) {
+	switch q := q.(type) {
+	case SelectQuery:
+		l, ok := q.Query().Select.(SelectSubLists)
+		if !ok {
+			return 0, false
+		}
+
+		for _, s := range l {
+			if _, ok := s.Value.(Raw); ok {
+				return 0, false
+			}
+		}
+
+		return len(l), true
+	case *QuerySet:
+		return selectListLen(q.Left)
 	}
-	fmt.Fprintf(&b, "%s", f.SubQuery)
 
-	return b.String()
+	return 0, false
 }
 
 type ColumnsConstructor struct {
@@ -112,34 +236,69 @@ func (f *FromConstructor) OverridingUserValue() *FromConstructor {
 	return f
 }
 
-func (f *FromConstructor) String() string {
-	var b strings.Builder
+func (f *FromConstructor) Accept(v Visitor) Visitor { return f.acceptOutput(v, nil) }
 
-	if len(f.Columns) > 0 {
-		fmt.Fprintf(&b, "%s ", f.Columns)
-	}
-	if f.Overriding != nil {
-		fmt.Fprintf(&b, "%s ", f.Overriding)
-	}
-	fmt.Fprintf(&b, "%s", f.Values)
-
-	return b.String()
+// acceptOutput renders the OUTPUT clause of SQL Server between the columns and the values.
+func (f *FromConstructor) acceptOutput(v Visitor, output Accepter) Visitor {
+	return v.IfNotNil(f.Columns, f.Columns, WS).
+		IfNotNil(f.Overriding, Stringer(f.Overriding), WS).
+		IfNotNil(output, output, WS).
+		Visit(f.Values)
 }
 
+func (f *FromConstructor) String() string { return XQL(f) }
+
 type ValueConstructor []TypedRowValueExpr
 
-func (c ValueConstructor) String() string {
+const kValues = Keyword("VALUES")
+
+func (c ValueConstructor) Accept(v Visitor) Visitor {
+	v.Visit(kValues)
+
 	if len(c) == 1 {
-		if _, ok := c[0].(rowsValue); ok {
-			return fmt.Sprintf("VALUES%s", Join(c, ", "))
+		switch r := c[0].(type) {
+		case rowsValue:
+			return v.Visit(r)
+		case rowValue:
+			return v.Visit(WS, r)
 		}
 	} else if len(c) > 1 {
 		if _, ok := c[1].(rowValue); ok {
-			return fmt.Sprintf("VALUES\n\t%s", Join(c, ",\n\t"))
+			for i, e := range c {
+				v.IfElse(i > 0, Raw(",\n\t"), Raw("\n\t"))
+
+				if r, ok := e.(rowValue); ok {
+					v.Visit(r.explicit())
+				} else {
+					v.Visit(accept(e))
+				}
+			}
+
+			return v
 		}
 	}
 
-	return fmt.Sprintf("VALUES (%s)", Join(c, ", "))
+	return v.Visit(WS, Paren(Joins(accepts(c), Sep)))
+}
+
+func (c ValueConstructor) String() string { return XQL(c) }
+
+// rows returns the values of every constructed row.
+func (c ValueConstructor) rows() (rows [][]TypedRowValueExpr) {
+	for _, e := range c {
+		switch r := e.(type) {
+		case rowsValue:
+			for _, row := range r {
+				rows = append(rows, row)
+			}
+		case rowValue:
+			rows = append(rows, r)
+		default:
+			return [][]TypedRowValueExpr{c}
+		}
+	}
+
+	return
 }
 
 type FromDefault struct{}
@@ -148,4 +307,7 @@ var DefaultValues = &FromDefault{}
 
 func (f *FromDefault) insertFrom() InsertFrom { return f }
 
-func (f *FromDefault) String() string { return "DEFAULT VALUES" }
+const kDefaultValues = Keyword("DEFAULT VALUES")
+
+func (f *FromDefault) Accept(v Visitor) Visitor { return v.Visit(kDefaultValues) }
+func (f *FromDefault) String() string           { return XQL(f) }
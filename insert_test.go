@@ -11,15 +11,15 @@ func ExampleInsertInto_default() {
 	fmt.Println(InsertInto("products", Columns("product_no", "name", "price").Values(1, "Cheese", Default)))
 	// Output:
 	// INSERT INTO products DEFAULT VALUES
-	// INSERT INTO products (product_no, name, price) VALUES (1, "Cheese", DEFAULT)
+	// INSERT INTO products (product_no, name, price) VALUES (1, 'Cheese', DEFAULT)
 }
 
 func ExampleInsertInto_values() {
 	fmt.Println(InsertInto("products", Values(1, "Cheese", 9.99)))
 	fmt.Println(InsertInto("products", Columns("product_no", "name", "price").Values(1, "Cheese", 9.99)))
 	// Output:
-	// INSERT INTO products VALUES (1, "Cheese", 9.99)
-	// INSERT INTO products (product_no, name, price) VALUES (1, "Cheese", 9.99)
+	// INSERT INTO products VALUES (1, 'Cheese', 9.99)
+	// INSERT INTO products (product_no, name, price) VALUES (1, 'Cheese', 9.99)
 }
 
 func ExampleInsertInto_rows() {
@@ -35,11 +35,53 @@ func ExampleInsertInto_rows() {
 	)))
 	// Output:
 	// INSERT INTO products (product_no, name, price) VALUES
-	// 	ROW(1, "Cheese", 9.99),
-	// 	ROW(2, "Bread", 1.99),
-	// 	ROW(3, "Milk", 2.99)
+	// 	ROW(1, 'Cheese', 9.99),
+	// 	ROW(2, 'Bread', 1.99),
+	// 	ROW(3, 'Milk', 2.99)
 	// INSERT INTO products (product_no, name, price) VALUES
-	// 	ROW(1, "Cheese", 9.99),
-	// 	ROW(2, "Bread", 1.99),
-	// 	ROW(3, "Milk", 2.99)
+	// 	ROW(1, 'Cheese', 9.99),
+	// 	ROW(2, 'Bread', 1.99),
+	// 	ROW(3, 'Milk', 2.99)
+}
+
+func ExampleInsertInto_dialect() {
+	stmt := InsertInto("products", Columns("product_no", "name").Values(
+		Row{1, "Cheese"},
+		Row{2, "Bread"},
+	))
+
+	fmt.Println(StandardSQL.XQL(stmt))
+	fmt.Println(PostgreSQL.XQL(stmt))
+	// Output:
+	// INSERT INTO products (product_no, name) VALUES
+	// 	ROW(1, 'Cheese'),
+	// 	ROW(2, 'Bread')
+	// INSERT INTO products (product_no, name) VALUES
+	// 	(1, 'Cheese'),
+	// 	(2, 'Bread')
+}
+
+func ExampleInsertInto_strings() {
+	stmt := InsertInto("files", Columns("owner", "path").Values("O'Brien", `C:\temp`))
+
+	fmt.Println(PostgreSQL.XQL(stmt))
+	fmt.Println(MySQL.XQL(stmt))
+	// Output:
+	// INSERT INTO files (owner, path) VALUES ('O''Brien', 'C:\temp')
+	// INSERT INTO files (owner, path) VALUES ('O''Brien', 'C:\\temp')
+}
+
+func ExampleInsertInto_select() {
+	fmt.Println(InsertInto("films").Select(Select(Asterisk).From(QName("tmp_films"))))
+	fmt.Println(InsertInto("archive").Columns("id", "name").
+		Select(Select(Column("id"), Column("name")).From(QName("products")).Where(Raw("discontinued"))))
+	fmt.Println(InsertInto("archive").Columns("id", "name").Select(Select(Raw("p.*")).From(QName("products").As("p"))))
+
+	_, err := Build(InsertInto("archive").Columns("id", "name").Select(Select(Column("id")).From(QName("products"))))
+	fmt.Println(err)
+	// Output:
+	// INSERT INTO films SELECT * FROM tmp_films
+	// INSERT INTO archive (id, name) SELECT id, name FROM products WHERE discontinued
+	// INSERT INTO archive (id, name) SELECT p.* FROM products AS p
+	// xql: column count mismatch: 2 columns, 1 selected
 }
@@ -2,7 +2,6 @@ package xql
 
 import (
 	"fmt"
-	"strings"
 )
 
 type ToJoinedTable interface {
@@ -27,9 +26,14 @@ type CrossJoin struct {
 	Right TableFactor
 }
 
+const kCrossJoin = Keyword("CROSS JOIN")
+
 func (j *CrossJoin) tableRef() TableRef       { return j }
 func (j *CrossJoin) joinedTable() JoinedTable { return j }
-func (j *CrossJoin) String() string           { return fmt.Sprintf("%s CROSS JOIN %s", j.Left, j.Right) }
+func (j *CrossJoin) Accept(v Visitor) Visitor {
+	return v.Visit(accept(j.Left), WS, kCrossJoin, WS, &j.Right)
+}
+func (j *CrossJoin) String() string { return XQL(j) }
 
 //go:generate stringer -type JoinType -linecomment
 
@@ -44,6 +48,12 @@ const (
 
 func (t JoinType) Outer() bool { return t != JoinInner }
 
+const kJoin = Keyword("JOIN")
+
+func (t JoinType) Accept(v Visitor) Visitor {
+	return v.If(t.Outer(), Keyword(t.String()), WS).Visit(kJoin)
+}
+
 type QualifiedJoin struct {
 	Left  Either[TableRef, *PartitionedJoinedTable]
 	Type  JoinType
@@ -53,45 +63,41 @@ type QualifiedJoin struct {
 
 func (j *QualifiedJoin) tableRef() TableRef       { return j }
 func (j *QualifiedJoin) joinedTable() JoinedTable { return j }
-func (j *QualifiedJoin) String() string {
-	var b strings.Builder
-
-	fmt.Fprintf(&b, "%s ", j.Left)
-	if j.Type.Outer() {
-		fmt.Fprintf(&b, "%s ", j.Type)
-	}
-	fmt.Fprintf(&b, "JOIN %s %s", j.Right, j.Spec)
-
-	return b.String()
+func (j *QualifiedJoin) Accept(v Visitor) Visitor {
+	return v.Visit(&j.Left, WS, j.Type, WS, &j.Right, WS, &j.Spec)
 }
+func (j *QualifiedJoin) String() string { return XQL(j) }
 
 type JoinSpec struct {
 	On    *JoinCond
 	Using *NamedColumnsJoin
 }
 
+func (j *JoinSpec) Accept(v Visitor) Visitor {
+	return v.IfElse(j.On != nil, j.On, j.Using)
+}
+func (j *JoinSpec) String() string { return XQL(j) }
+
 type JoinCond struct {
 	Search SearchCond
 }
 
-func (j *JoinCond) String() string { return fmt.Sprintf("ON %s", j.Search) }
+const kOn = Keyword("ON")
+
+func (j *JoinCond) Accept(v Visitor) Visitor { return v.Visit(kOn, WS, accept(j.Search)) }
+func (j *JoinCond) String() string           { return XQL(j) }
 
 type NamedColumnsJoin struct {
 	Columns ColumnNameList
 	As      string
 }
 
-func (j *NamedColumnsJoin) String() string {
-	var b strings.Builder
-
-	fmt.Fprintf(&b, "USING %s", j.Columns)
+const kUsing = Keyword("USING")
 
-	if j.As != "" {
-		fmt.Fprintf(&b, "AS %s", j.As)
-	}
-
-	return b.String()
+func (j *NamedColumnsJoin) Accept(v Visitor) Visitor {
+	return v.Visit(kUsing, WS, j.Columns).If(j.As != "", WS, kAs, WS, Raw(j.As))
 }
+func (j *NamedColumnsJoin) String() string { return XQL(j) }
 
 type NaturalJoin struct {
 	Left  TableRef
@@ -99,19 +105,14 @@ type NaturalJoin struct {
 	Right TableFactor
 }
 
+const kNatural = Keyword("NATURAL")
+
 func (j *NaturalJoin) tableRef() TableRef       { return j }
 func (j *NaturalJoin) joinedTable() JoinedTable { return j }
-func (j *NaturalJoin) String() string {
-	var b strings.Builder
-
-	fmt.Fprintf(&b, "%s NATURAL ", j.Left)
-	if j.Type.Outer() {
-		fmt.Fprintf(&b, "%s ", j.Type)
-	}
-	fmt.Fprintf(&b, "JOIN %s", j.Right)
-
-	return b.String()
+func (j *NaturalJoin) Accept(v Visitor) Visitor {
+	return v.Visit(accept(j.Left), WS, kNatural, WS, j.Type, WS, &j.Right)
 }
+func (j *NaturalJoin) String() string { return XQL(j) }
 
 type PartitionedJoinColumnRef = ColumnRef
 
@@ -120,6 +121,36 @@ type PartitionedJoinedTable struct {
 	Columns []PartitionedJoinColumnRef
 }
 
-func (t *PartitionedJoinedTable) String() string {
-	return fmt.Sprintf("%s PARTITION BY %s", t.Table, strings.Join(t.Columns, ", "))
+const kPartitionBy = Keyword("PARTITION BY")
+
+func (t *PartitionedJoinedTable) Accept(v Visitor) Visitor {
+	return v.Visit(&t.Table, WS, kPartitionBy, WS, ColumnNameList(t.Columns))
+}
+func (t *PartitionedJoinedTable) String() string { return XQL(t) }
+
+func newQualifiedJoin(left ToTableRef, t JoinType, right ToTableRef) *QualifiedJoin {
+	return &QualifiedJoin{
+		Left:  Left[TableRef, *PartitionedJoinedTable](left.tableRef()),
+		Type:  t,
+		Right: Left[TableRef, *PartitionedJoinedTable](right.tableRef()),
+	}
+}
+
+func InnerJoin(left, right ToTableRef) *QualifiedJoin {
+	return newQualifiedJoin(left, JoinInner, right)
+}
+func LeftJoin(left, right ToTableRef) *QualifiedJoin { return newQualifiedJoin(left, JoinLeft, right) }
+func RightJoin(left, right ToTableRef) *QualifiedJoin {
+	return newQualifiedJoin(left, JoinRight, right)
+}
+func FullJoin(left, right ToTableRef) *QualifiedJoin { return newQualifiedJoin(left, JoinFull, right) }
+
+func (j *QualifiedJoin) On(cond SearchCond) *QualifiedJoin {
+	j.Spec = JoinSpec{On: &JoinCond{cond}}
+	return j
+}
+
+func (j *QualifiedJoin) Using(x ...ColumnName) *QualifiedJoin {
+	j.Spec = JoinSpec{Using: &NamedColumnsJoin{Columns: x}}
+	return j
 }
@@ -1,10 +1,5 @@
 package xql
 
-import (
-	"fmt"
-	"strings"
-)
-
 //go:generate stringer -type=LikeAction -linecomment
 
 type LikeAction int
@@ -62,8 +57,19 @@ var (
 func Excluding(p LikeProperty) *LikeOption { return &LikeOption{LikeExcluding, p} }
 func Including(p LikeProperty) *LikeOption { return &LikeOption{LikeIncluding, p} }
 
-func (o *LikeOption) String() string { return fmt.Sprintf("%s %s", o.Action, o.Property) }
+func (o *LikeOption) Accept(v Visitor) Visitor {
+	only(v, "LIKE ... "+o.Action.String()+" "+o.Property.String(), PostgreSQL)
+
+	return v.Visit(Keyword(o.Action.String()), WS, Keyword(o.Property.String()))
+}
 
+func (o *LikeOption) String() string { return XQL(o) }
+
+// LikeClause copies the column definitions of another table.
+//
+//	<like clause> ::= LIKE <table name> [ <like options> ]
+//
+// MySQL only copies a whole table, with the CREATE TABLE a LIKE b form.
 type LikeClause struct {
 	Name    *TableName
 	Options []*LikeOption
@@ -78,17 +84,16 @@ func Like[T ToTableName](name T, x ...*LikeOption) *LikeClause {
 func (c *LikeClause) tableElement() TableElement { return c }
 
 func (c *LikeClause) applyTableDef(t *TableDef) {
-	t.Content = TableElementList{c}
+	l, _ := t.Content.(TableElementList)
+	t.Content = TableElementList(append(l, c))
 }
 
-func (c *LikeClause) String() string {
-	var b strings.Builder
-
-	fmt.Fprintf(&b, "LIKE %s", c.Name)
+const kLike = Keyword("LIKE")
 
-	if len(c.Options) > 0 {
-		fmt.Fprintf(&b, " %s", Join(c.Options, " "))
-	}
+func (c *LikeClause) Accept(v Visitor) Visitor {
+	only(v, "LIKE", PostgreSQL, MySQL)
 
-	return b.String()
+	return v.Visit(kLike, WS, c.Name).IfNotNil(c.Options, WS, Joins(c.Options, WS))
 }
+
+func (c *LikeClause) String() string { return XQL(c) }
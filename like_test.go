@@ -13,3 +13,29 @@ func ExampleLike() {
 	// 	LIKE foo INCLUDING ALL EXCLUDING DEFAULTS
 	// )
 }
+
+func ExampleLikeClause() {
+	clone := CreateTable("films_copy", Like("films"))
+	mixed := CreateTable("films_ext", Like("films", IncludingDefaults), Column("note", Text))
+
+	for _, d := range []Dialect{PostgreSQL, MySQL, SQLite} {
+		s, err := d.Build(clone)
+		fmt.Printf("%s: %s %v\n", d, s, err)
+	}
+
+	fmt.Println(PostgreSQL.XQL(mixed))
+
+	_, err := MySQL.Build(mixed)
+	fmt.Println(err)
+	// Output:
+	// PostgreSQL: CREATE TABLE films_copy (
+	// 	LIKE films
+	// ) <nil>
+	// MySQL: CREATE TABLE films_copy LIKE films <nil>
+	// SQLite:  xql: unsupported by the dialect: LIKE
+	// CREATE TABLE films_ext (
+	// 	LIKE films INCLUDING DEFAULTS,
+	// 	note TEXT
+	// )
+	// xql: unsupported by the dialect: LIKE with other table elements
+}
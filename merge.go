@@ -2,17 +2,17 @@ package xql
 
 import (
 	"fmt"
-	"strings"
 )
 
 type MergeCorrelationName = CorrelationName
 
 type MergeStmt struct {
-	Target TargetTable
-	Alias  MergeCorrelationName
-	Source TableRef
-	Join   SearchCond
-	When   MergeWhenClause
+	Target  TargetTable
+	Alias   MergeCorrelationName
+	Source  TableRef
+	Join    SearchCond
+	Clauses []MergeWhenClause
+	Return  *ReturningClause
 }
 
 type MergeIntoClause struct {
@@ -44,29 +44,65 @@ func (c *MergeIntoUsingClause) On(join SearchCond) *MergeStmt {
 	return c.s
 }
 
-func (s *MergeStmt) String() string {
-	var b strings.Builder
-
-	fmt.Fprintf(&b, "MERGE INTO %s", s.Target)
-
-	if len(s.Alias) > 0 {
-		fmt.Fprintf(&b, " AS %s", s.Alias)
-	}
+// When appends the WHEN clauses, they are evaluated in order.
+func (s *MergeStmt) When(x ...MergeWhenClause) *MergeStmt {
+	s.Clauses = append(s.Clauses, x...)
+	return s
+}
 
-	fmt.Fprintf(&b, " USING %s ON %s", s.Source, s.Join)
+// Returning returns the columns of the merged rows,
+// MergeAction tells which action merged each of them.
+func (s *MergeStmt) Returning(x ...SelectFieldOrAsterisk) *MergeStmt {
+	s.Return = Returning(x...)
+	return s
+}
 
-	return b.String()
+const kMergeInto = Keyword("MERGE INTO")
+
+func (s *MergeStmt) Accept(v Visitor) Visitor {
+	only(v, "MERGE", PostgreSQL, SQLServer, Oracle)
+
+	return v.Visit(kMergeInto, WS, accept(s.Target)).
+		If(len(s.Alias) > 0, WS, AcceptFunc(func(v Visitor) Visitor {
+			return v.If(v.Dialect() != Oracle, kAs, WS).Visit(Raw(s.Alias))
+		})).
+		Visit(WS, kUsing, WS, accept(s.Source), WS, kOn, WS).
+		IfElse(v.Dialect() == Oracle, Paren(accept(s.Join)), accept(s.Join)).
+		IfNotNil(s.Clauses, WS, Joins(s.Clauses, WS)).
+		IfNotNil(s.Return, WS, AcceptFunc(func(v Visitor) Visitor {
+			return v.IfElse(v.Dialect() == SQLServer, s.Return.output(kInserted), s.Return)
+		})).
+		If(v.Dialect() == SQLServer, Token(';'))
 }
 
+func (s *MergeStmt) String() string { return XQL(s) }
+
 type MergeWhenClause interface {
+	fmt.Stringer
+
+	Accepter
+
 	mergeWhenClause() MergeWhenClause
 }
 
 var (
 	_ MergeWhenClause = &MergeWhenMatchedClause{}
 	_ MergeWhenClause = &MergeWhenNotMatchedClause{}
+	_ MergeWhenClause = &MergeWhenNotMatchedBySourceClause{}
+)
+
+const (
+	kWhenMatched            = Keyword("WHEN MATCHED")
+	kWhenNotMatched         = Keyword("WHEN NOT MATCHED")
+	kWhenNotMatchedByTarget = Keyword("WHEN NOT MATCHED BY TARGET")
+	kWhenNotMatchedBySource = Keyword("WHEN NOT MATCHED BY SOURCE")
+	kThen                   = Keyword("THEN")
+	kDoNothing              = Keyword("DO NOTHING")
 )
 
+// MergeWhenMatchedClause updates or deletes the target rows which match a source row.
+//
+// WhenMatched is shared, every method returns a modified copy of the clause.
 type MergeWhenMatchedClause struct {
 	Cond           SearchCond
 	UpdateOrDelete MergeUpdateOrDeleteSpec
@@ -75,89 +111,169 @@ type MergeWhenMatchedClause struct {
 var WhenMatched = &MergeWhenMatchedClause{}
 
 func (c *MergeWhenMatchedClause) And(cond SearchCond) *MergeWhenMatchedClause {
-	c.Cond = cond
-	return c
+	cc := *c
+	cc.Cond = cond
+	return &cc
 }
 
 func (c *MergeWhenMatchedClause) ThenUpdate(x ...SetClause) *MergeWhenMatchedClause {
-	c.UpdateOrDelete = MergeUpdateSpec(x)
-	return c
+	cc := *c
+	cc.UpdateOrDelete = MergeUpdateSpec(x)
+	return &cc
 }
 
 func (c *MergeWhenMatchedClause) ThenDelete() *MergeWhenMatchedClause {
-	c.UpdateOrDelete = &MergeDeleteSpec{}
-	return c
+	cc := *c
+	cc.UpdateOrDelete = &MergeDeleteSpec{}
+	return &cc
 }
 
 func (c *MergeWhenMatchedClause) ThenDoNothing() *MergeWhenMatchedClause {
-	c.UpdateOrDelete = nil
-	return c
+	cc := *c
+	cc.UpdateOrDelete = nil
+	return &cc
 }
 
 func (c *MergeWhenMatchedClause) mergeWhenClause() MergeWhenClause { return c }
-func (c *MergeWhenMatchedClause) String() string {
-	var b strings.Builder
-
-	b.WriteString("WHEN MATCHED")
-
-	if c.Cond != nil {
-		fmt.Fprintf(&b, " AND %s", c.Cond)
+func (c *MergeWhenMatchedClause) Accept(v Visitor) Visitor {
+	if v.Dialect() == Oracle && c.Cond != nil {
+		return c.oracle(v)
 	}
 
-	if c.UpdateOrDelete != nil {
-		fmt.Fprintf(&b, " THEN %s", c.UpdateOrDelete)
-	} else {
-		b.WriteString(" THEN DO NOTHING")
+	return v.Visit(kWhenMatched).
+		IfNotNil(c.Cond, WS, kAnd, WS, accept(c.Cond)).
+		Visit(WS, kThen, WS).
+		IfElse(c.UpdateOrDelete != nil, accept(c.UpdateOrDelete), AcceptFunc(doNothing))
+}
+
+// oracle moves the condition into the WHERE clause of the update, Oracle doesn't support AND.
+func (c *MergeWhenMatchedClause) oracle(v Visitor) Visitor {
+	u, ok := c.UpdateOrDelete.(MergeUpdateSpec)
+	if !ok {
+		return v.Fail(fmt.Errorf("%w: conditional %s", ErrUnsupported, c))
 	}
 
-	return b.String()
+	return v.Visit(kWhenMatched, WS, kThen, WS, u, WS, kWhere, WS, accept(c.Cond))
 }
+func (c *MergeWhenMatchedClause) String() string { return XQL(c) }
 
+// MergeWhenNotMatchedClause inserts the source rows which match no target row.
+//
+// WhenNotMatched is shared, every method returns a modified copy of the clause.
 type MergeWhenNotMatchedClause struct {
-	Cond   SearchCond
-	Insert *MergeInsertSpec
+	ByTarget bool
+	Cond     SearchCond
+	Insert   *MergeInsertSpec
 }
 
-var WhenNotMatched = &MergeWhenNotMatchedClause{}
+var (
+	WhenNotMatched = &MergeWhenNotMatchedClause{}
+	// WhenNotMatchedByTarget is WhenNotMatched spelled out as on SQL Server and PostgreSQL.
+	WhenNotMatchedByTarget = &MergeWhenNotMatchedClause{ByTarget: true}
+)
 
 func (c *MergeWhenNotMatchedClause) And(cond SearchCond) *MergeWhenNotMatchedClause {
-	c.Cond = cond
-	return c
+	cc := *c
+	cc.Cond = cond
+	return &cc
 }
 
 func (c *MergeWhenNotMatchedClause) ThenInsert(from *FromConstructor) *MergeWhenNotMatchedClause {
-	c.Insert = (*MergeInsertSpec)(from)
-	return c
+	cc := *c
+	cc.Insert = (*MergeInsertSpec)(from)
+	return &cc
 }
 
 func (c *MergeWhenNotMatchedClause) ThenDoNothing() *MergeWhenNotMatchedClause {
-	c.Insert = nil
-	return c
+	cc := *c
+	cc.Insert = nil
+	return &cc
 }
 
 func (c *MergeWhenNotMatchedClause) mergeWhenClause() MergeWhenClause { return c }
 
-func (c *MergeWhenNotMatchedClause) String() string {
-	var b strings.Builder
+func (c *MergeWhenNotMatchedClause) Accept(v Visitor) Visitor {
+	if v.Dialect() == Oracle && c.Cond != nil {
+		return c.oracle(v)
+	}
 
-	b.WriteString("WHEN NOT MATCHED")
+	return v.IfElse(c.ByTarget && v.Dialect().Is(SQLServer, PostgreSQL), kWhenNotMatchedByTarget, kWhenNotMatched).
+		IfNotNil(c.Cond, WS, kAnd, WS, accept(c.Cond)).
+		Visit(WS, kThen, WS).
+		IfElse(c.Insert != nil, c.Insert, AcceptFunc(doNothing))
+}
 
-	if c.Cond != nil {
-		fmt.Fprintf(&b, " AND %s", c.Cond)
+// oracle moves the condition into the WHERE clause of the insert, Oracle doesn't support AND.
+func (c *MergeWhenNotMatchedClause) oracle(v Visitor) Visitor {
+	if c.Insert == nil {
+		return v.Fail(fmt.Errorf("%w: conditional %s", ErrUnsupported, c))
 	}
 
-	if c.Insert != nil {
-		fmt.Fprintf(&b, " THEN %s", c.Insert)
-	} else {
-		b.WriteString(" THEN DO NOTHING")
+	return v.Visit(kWhenNotMatched, WS, kThen, WS, c.Insert, WS, kWhere, WS, accept(c.Cond))
+}
+
+func (c *MergeWhenNotMatchedClause) String() string { return XQL(c) }
+
+// doNothing renders THEN DO NOTHING, SQL Server and Oracle have to omit the WHEN clause instead.
+func doNothing(v Visitor) Visitor {
+	if v.Dialect().Is(SQLServer, Oracle) {
+		return v.Fail(fmt.Errorf("%w: THEN DO NOTHING", ErrUnsupported))
 	}
 
-	return b.String()
+	return v.Visit(kDoNothing)
+}
+
+// MergeWhenNotMatchedBySourceClause updates or deletes the target rows which match no source row.
+//
+// It is supported by SQL Server and PostgreSQL 17, the PostgreSQL dialect targets 17 as it does for
+// RETURNING and merge_action(), Oracle has no equivalent.
+type MergeWhenNotMatchedBySourceClause struct {
+	Cond           SearchCond
+	UpdateOrDelete MergeUpdateOrDeleteSpec
+}
+
+var WhenNotMatchedBySource = &MergeWhenNotMatchedBySourceClause{}
+
+func (c *MergeWhenNotMatchedBySourceClause) And(cond SearchCond) *MergeWhenNotMatchedBySourceClause {
+	cc := *c
+	cc.Cond = cond
+	return &cc
+}
+
+func (c *MergeWhenNotMatchedBySourceClause) ThenUpdate(x ...SetClause) *MergeWhenNotMatchedBySourceClause {
+	cc := *c
+	cc.UpdateOrDelete = MergeUpdateSpec(x)
+	return &cc
+}
+
+func (c *MergeWhenNotMatchedBySourceClause) ThenDelete() *MergeWhenNotMatchedBySourceClause {
+	cc := *c
+	cc.UpdateOrDelete = &MergeDeleteSpec{}
+	return &cc
+}
+
+func (c *MergeWhenNotMatchedBySourceClause) ThenDoNothing() *MergeWhenNotMatchedBySourceClause {
+	cc := *c
+	cc.UpdateOrDelete = nil
+	return &cc
 }
 
+func (c *MergeWhenNotMatchedBySourceClause) mergeWhenClause() MergeWhenClause { return c }
+func (c *MergeWhenNotMatchedBySourceClause) Accept(v Visitor) Visitor {
+	only(v, kWhenNotMatchedBySource.String(), PostgreSQL, SQLServer)
+
+	return v.Visit(kWhenNotMatchedBySource).
+		IfNotNil(c.Cond, WS, kAnd, WS, accept(c.Cond)).
+		Visit(WS, kThen, WS).
+		IfElse(c.UpdateOrDelete != nil, accept(c.UpdateOrDelete), AcceptFunc(doNothing))
+}
+func (c *MergeWhenNotMatchedBySourceClause) String() string { return XQL(c) }
+
 type MergeUpdateOrDeleteSpec interface {
 	fmt.Stringer
 
+	Accepter
+
 	mergeUpdateOrDeleteSpec() MergeUpdateOrDeleteSpec
 }
 
@@ -168,14 +284,45 @@ var (
 
 type MergeUpdateSpec SetClauseList
 
+const kUpdateSet = Keyword("UPDATE SET")
+
 func (s MergeUpdateSpec) mergeUpdateOrDeleteSpec() MergeUpdateOrDeleteSpec { return s }
-func (s MergeUpdateSpec) String() string                                   { return fmt.Sprintf("UPDATE SET %s", SetClauseList(s)) }
+func (s MergeUpdateSpec) Accept(v Visitor) Visitor                         { return v.Visit(kUpdateSet, WS, SetClauseList(s)) }
+func (s MergeUpdateSpec) String() string                                   { return XQL(s) }
 
 type MergeDeleteSpec struct{}
 
 func (s *MergeDeleteSpec) mergeUpdateOrDeleteSpec() MergeUpdateOrDeleteSpec { return s }
-func (s *MergeDeleteSpec) String() string                                   { return "DELETE" }
+func (s *MergeDeleteSpec) Accept(v Visitor) Visitor                         { return v.Visit(kDelete) }
+func (s *MergeDeleteSpec) String() string                                   { return XQL(s) }
 
 type MergeInsertSpec FromConstructor
 
-func (s *MergeInsertSpec) String() string { return fmt.Sprintf("INSERT %s", (*FromConstructor)(s)) }
+const kInsert = Keyword("INSERT")
+
+func (s *MergeInsertSpec) Accept(v Visitor) Visitor {
+	return v.Visit(kInsert, WS, (*FromConstructor)(s))
+}
+func (s *MergeInsertSpec) String() string { return XQL(s) }
+
+// MergeActionExpr returns the action of the WHEN clause which merged the row,
+// it is rendered as merge_action() on PostgreSQL and as $action on SQL Server.
+type MergeActionExpr struct{}
+
+var MergeAction = &MergeActionExpr{}
+
+const (
+	kMergeAction       = Keyword("merge_action()")
+	kMergeActionOutput = Keyword("$action")
+)
+
+func (e *MergeActionExpr) expr() Expr                              { return e }
+func (e *MergeActionExpr) selectSubList() *SelectSubList           { return &SelectSubList{Value: e} }
+func (e *MergeActionExpr) applySelectList(l SelectList) SelectList { return appendSelectList(l, e) }
+func (e *MergeActionExpr) As(name ColumnName) *SelectSubList {
+	return &SelectSubList{e, AsClause(name)}
+}
+func (e *MergeActionExpr) Accept(v Visitor) Visitor {
+	return v.IfElse(v.Dialect() == SQLServer, kMergeActionOutput, kMergeAction)
+}
+func (e *MergeActionExpr) String() string { return XQL(e) }
@@ -13,3 +13,49 @@ func ExampleMergeInto() {
 	// Output:
 	// MERGE INTO customer_account AS ca USING recent_transactions AS t ON t.customer_id = ca.customer_id
 }
+
+func ExampleMergeStmt_When() {
+	merge := MergeInto("inventory").As("i").
+		Using(QName("shipments").As("s")).
+		On(Raw("i.item_id = s.item_id")).
+		When(
+			WhenMatched.And(Raw("s.qty = 0")).ThenDelete(),
+			WhenMatched.ThenUpdate(Assign("qty", Raw("i.qty + s.qty"))),
+			WhenNotMatchedByTarget.ThenInsert(Columns("item_id", "qty").Values(Row{Raw("s.item_id"), Raw("s.qty")})),
+			WhenNotMatchedBySource.ThenDoNothing(),
+		).
+		Returning(MergeAction, Raw("i.item_id"))
+
+	fmt.Println(merge)
+	fmt.Println(PostgreSQL.XQL(merge))
+
+	_, err := SQLServer.Build(merge)
+	fmt.Println(err)
+	// Output:
+	// MERGE INTO inventory AS i USING shipments AS s ON i.item_id = s.item_id WHEN MATCHED AND s.qty = 0 THEN DELETE WHEN MATCHED THEN UPDATE SET qty = i.qty + s.qty WHEN NOT MATCHED THEN INSERT (item_id, qty) VALUES (s.item_id, s.qty) WHEN NOT MATCHED BY SOURCE THEN DO NOTHING RETURNING merge_action(), i.item_id
+	// MERGE INTO inventory AS i USING shipments AS s ON i.item_id = s.item_id WHEN MATCHED AND s.qty = 0 THEN DELETE WHEN MATCHED THEN UPDATE SET qty = i.qty + s.qty WHEN NOT MATCHED BY TARGET THEN INSERT (item_id, qty) VALUES (s.item_id, s.qty) WHEN NOT MATCHED BY SOURCE THEN DO NOTHING RETURNING merge_action(), i.item_id
+	// xql: unsupported by the dialect: THEN DO NOTHING
+}
+
+func ExampleMergeInsertSpec() {
+	fmt.Println(WhenNotMatched.ThenInsert(Columns("id", "name").Values(Row{1, "x"}).OverridingSystemValue()))
+	// Output:
+	// WHEN NOT MATCHED THEN INSERT (id, name) OVERRIDING SYSTEM VALUE VALUES (1, 'x')
+}
+
+func ExampleMergeWhenNotMatchedClause_And() {
+	merge := MergeInto("inventory").As("i").
+		Using(QName("shipments").As("s")).
+		On(Raw("i.item_id = s.item_id")).
+		When(WhenNotMatched.And(Raw("s.qty > 0")).ThenInsert(Columns("item_id", "qty").Values(Row{Raw("s.item_id"), Raw("s.qty")})))
+
+	fmt.Println(PostgreSQL.XQL(merge))
+	fmt.Println(Oracle.XQL(merge))
+
+	_, err := Oracle.Build(merge.When(WhenNotMatchedBySource.ThenDelete()))
+	fmt.Println(err)
+	// Output:
+	// MERGE INTO inventory AS i USING shipments AS s ON i.item_id = s.item_id WHEN NOT MATCHED AND s.qty > 0 THEN INSERT (item_id, qty) VALUES (s.item_id, s.qty)
+	// MERGE INTO inventory i USING shipments s ON (i.item_id = s.item_id) WHEN NOT MATCHED THEN INSERT (item_id, qty) VALUES (s.item_id, s.qty) WHERE s.qty > 0
+	// xql: unsupported by the dialect: WHEN NOT MATCHED BY SOURCE
+}
@@ -11,3 +11,201 @@ var _ DataType = &MultiSetType{}
 func (t *MultiSetType) dataType() DataType          { return t }
 func (t *MultiSetType) applyColumnDef(d *ColumnDef) { d.Type = t }
 func (t *MultiSetType) String() string              { return fmt.Sprintf("%s MULTISET", t.Type) }
+
+// MultiSetValue is a multiset value constructor by enumeration.
+//
+//	<multiset value constructor by enumeration> ::= MULTISET <left bracket or trigraph> <multiset element list> <right bracket or trigraph>
+type MultiSetValue []ValueExpr
+
+// MultiSet constructs a multiset value from the elements.
+func MultiSet(x ...any) MultiSetValue {
+	m := make(MultiSetValue, len(x))
+
+	for i, v := range x {
+		m[i] = newTypedRowValueExpr(v)
+	}
+
+	return m
+}
+
+func (m MultiSetValue) expr() Expr { return m }
+func (m MultiSetValue) Accept(v Visitor) Visitor {
+	return v.Visit(kMultiSet, Bracket(Joins(accepts(m), Sep)))
+}
+func (m MultiSetValue) String() string { return XQL(m) }
+
+// MultiSetQuery is a multiset value constructor by query.
+//
+//	<multiset value constructor by query> ::= MULTISET <table subquery>
+type MultiSetQuery struct {
+	Query QueryExprBody
+}
+
+// MultiSetOfQuery constructs a multiset value from the rows of the query.
+func MultiSetOfQuery(q QueryExprBody) *MultiSetQuery { return &MultiSetQuery{q} }
+
+func (m *MultiSetQuery) expr() Expr               { return m }
+func (m *MultiSetQuery) Accept(v Visitor) Visitor { return v.Visit(kMultiSet, Paren(accept(m.Query))) }
+func (m *MultiSetQuery) String() string           { return XQL(m) }
+
+// MultiSetExpr combines two multisets with a set operation.
+//
+//	<multiset value expression> ::=
+//		<multiset term>
+//		| <multiset value expression> MULTISET UNION [ ALL | DISTINCT ] <multiset term>
+//		| <multiset value expression> MULTISET EXCEPT [ ALL | DISTINCT ] <multiset term>
+//
+//	<multiset term> ::= <multiset primary>
+//		| <multiset term> MULTISET INTERSECT [ ALL | DISTINCT ] <multiset primary>
+type MultiSetExpr struct {
+	Left  ValueExpr
+	Op    SetOperation
+	Set   *SetQuantifier
+	Right ValueExpr
+}
+
+func multiSetExpr(left any, op SetOperation, right any) *MultiSetExpr {
+	return &MultiSetExpr{Left: newTypedRowValueExpr(left), Op: op, Right: newTypedRowValueExpr(right)}
+}
+
+// MultiSetUnion returns the elements of both multisets.
+func MultiSetUnion(left, right any) *MultiSetExpr { return multiSetExpr(left, SetUnion, right) }
+
+// MultiSetExcept returns the elements of the left multiset which are not in the right multiset.
+func MultiSetExcept(left, right any) *MultiSetExpr { return multiSetExpr(left, SetExceptions, right) }
+
+// MultiSetIntersect returns the elements which are in both multisets.
+func MultiSetIntersect(left, right any) *MultiSetExpr { return multiSetExpr(left, SetIntersect, right) }
+
+// All keeps the duplicates of the result.
+func (e *MultiSetExpr) All() *MultiSetExpr {
+	q := SetAll
+	e.Set = &q
+	return e
+}
+
+// Distinct removes the duplicates of the result.
+func (e *MultiSetExpr) Distinct() *MultiSetExpr {
+	q := SetDistinct
+	e.Set = &q
+	return e
+}
+
+// operand parenthesizes a nested multiset operation, unless it binds tighter than the operation,
+// or it is the left operand of the same operation.
+func (e *MultiSetExpr) operand(x ValueExpr, left bool) Accepter {
+	if o, ok := x.(*MultiSetExpr); ok {
+		tighter := o.Op == SetIntersect && e.Op != SetIntersect
+		same := left && o.Op == e.Op
+
+		if !tighter && !same {
+			return Paren(o)
+		}
+	}
+
+	return accept(x)
+}
+
+func (e *MultiSetExpr) expr() Expr { return e }
+func (e *MultiSetExpr) Accept(v Visitor) Visitor {
+	return v.Visit(e.operand(e.Left, true), WS, kMultiSet, WS, Keyword(e.Op.String())).
+		IfNotNil(e.Set, WS, Stringer(e.Set)).
+		Visit(WS, e.operand(e.Right, false))
+}
+func (e *MultiSetExpr) String() string { return XQL(e) }
+
+// MemberPredicate tests if a value is an element of the multiset.
+//
+//	<member predicate> ::= <row value predicand> [ NOT ] MEMBER [ OF ] <multiset value expression>
+type MemberPredicate struct {
+	Value    ValueExpr
+	Not      bool
+	MultiSet ValueExpr
+}
+
+// MemberOf tests if the value is an element of the multiset.
+func MemberOf(value, multiset any) *MemberPredicate {
+	return &MemberPredicate{newTypedRowValueExpr(value), false, newTypedRowValueExpr(multiset)}
+}
+
+// NotMemberOf tests if the value is not an element of the multiset.
+func NotMemberOf(value, multiset any) *MemberPredicate {
+	return &MemberPredicate{newTypedRowValueExpr(value), true, newTypedRowValueExpr(multiset)}
+}
+
+const kMemberOf = Keyword("MEMBER OF")
+
+func (p *MemberPredicate) expr() Expr                   { return p }
+func (p *MemberPredicate) boolValueExpr() BoolValueExpr { return p }
+func (p *MemberPredicate) Accept(v Visitor) Visitor {
+	return v.Visit(accept(p.Value), WS).If(p.Not, kNot, WS).Visit(kMemberOf, WS, accept(p.MultiSet))
+}
+func (p *MemberPredicate) String() string { return XQL(p) }
+
+// SubMultiSetPredicate tests if every element of a multiset is an element of another multiset.
+//
+//	<submultiset predicate> ::= <row value predicand> [ NOT ] SUBMULTISET [ OF ] <multiset value expression>
+type SubMultiSetPredicate struct {
+	Value    ValueExpr
+	Not      bool
+	MultiSet ValueExpr
+}
+
+// SubMultiSetOf tests if the multiset is a submultiset of the other one.
+func SubMultiSetOf(multiset, of any) *SubMultiSetPredicate {
+	return &SubMultiSetPredicate{newTypedRowValueExpr(multiset), false, newTypedRowValueExpr(of)}
+}
+
+// NotSubMultiSetOf tests if the multiset is not a submultiset of the other one.
+func NotSubMultiSetOf(multiset, of any) *SubMultiSetPredicate {
+	return &SubMultiSetPredicate{newTypedRowValueExpr(multiset), true, newTypedRowValueExpr(of)}
+}
+
+const kSubMultiSetOf = Keyword("SUBMULTISET OF")
+
+func (p *SubMultiSetPredicate) expr() Expr                   { return p }
+func (p *SubMultiSetPredicate) boolValueExpr() BoolValueExpr { return p }
+func (p *SubMultiSetPredicate) Accept(v Visitor) Visitor {
+	return v.Visit(accept(p.Value), WS).If(p.Not, kNot, WS).Visit(kSubMultiSetOf, WS, accept(p.MultiSet))
+}
+func (p *SubMultiSetPredicate) String() string { return XQL(p) }
+
+// SetPredicate tests if the multiset has no duplicates.
+//
+//	<set predicate> ::= <row value predicand> IS [ NOT ] A SET
+type SetPredicate struct {
+	MultiSet ValueExpr
+	Not      bool
+}
+
+// IsASet tests if the multiset has no duplicates.
+func IsASet(multiset any) *SetPredicate { return &SetPredicate{newTypedRowValueExpr(multiset), false} }
+
+// IsNotASet tests if the multiset has duplicates.
+func IsNotASet(multiset any) *SetPredicate {
+	return &SetPredicate{newTypedRowValueExpr(multiset), true}
+}
+
+const kASet = Keyword("A SET")
+
+func (p *SetPredicate) expr() Expr                   { return p }
+func (p *SetPredicate) boolValueExpr() BoolValueExpr { return p }
+func (p *SetPredicate) Accept(v Visitor) Visitor {
+	return v.Visit(accept(p.MultiSet), WS, kIs, WS).If(p.Not, kNot, WS).Visit(kASet)
+}
+func (p *SetPredicate) String() string { return XQL(p) }
+
+// SetOf removes the duplicates from the multiset.
+func SetOf(multiset any) *CallExpr { return Call("SET", multiset) }
+
+// Element returns the only element of the multiset.
+func Element(multiset any) *CallExpr { return Call("ELEMENT", multiset) }
+
+// Collect aggregates the values into a multiset.
+func Collect(value any) *CallExpr { return Call("COLLECT", value) }
+
+// Fusion aggregates the multisets into a multiset with all of their elements.
+func Fusion(multiset any) *CallExpr { return Call("FUSION", multiset) }
+
+// Intersection aggregates the multisets into a multiset with the elements common to all of them.
+func Intersection(multiset any) *CallExpr { return Call("INTERSECTION", multiset) }
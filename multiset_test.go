@@ -0,0 +1,63 @@
+package xql_test
+
+import (
+	"fmt"
+
+	. "github.com/flier/xql"
+)
+
+func ExampleMultiSet() {
+	tags := Column("tags")
+
+	fmt.Println(MultiSet(1, 2, 2))
+	fmt.Println(MultiSetOfQuery(Select(Column("tag")).From(QName("post_tags"))))
+	fmt.Println(MultiSetUnion(tags, MultiSet("go")))
+	fmt.Println(MultiSetExcept(tags, MultiSet("go")).All())
+	fmt.Println(MultiSetIntersect(tags, Column("other_tags")).Distinct())
+	fmt.Println(SetOf(tags))
+	fmt.Println(Element(MultiSet(1)))
+	fmt.Println(Select(Collect(Column("tag")), Fusion(tags), Intersection(tags)).From(QName("posts")))
+	// Output:
+	// MULTISET[1, 2, 2]
+	// MULTISET(SELECT tag FROM post_tags)
+	// tags MULTISET UNION MULTISET['go']
+	// tags MULTISET EXCEPT ALL MULTISET['go']
+	// tags MULTISET INTERSECT DISTINCT other_tags
+	// SET(tags)
+	// ELEMENT(MULTISET[1])
+	// SELECT COLLECT(tag), FUSION(tags), INTERSECTION(tags) FROM posts
+}
+
+func ExampleMultiSetExcept() {
+	a, b, c := Column("a"), Column("b"), Column("c")
+
+	fmt.Println(MultiSetExcept(a, MultiSetUnion(b, c)))
+	fmt.Println(MultiSetExcept(MultiSetUnion(a, b), c))
+	fmt.Println(MultiSetUnion(MultiSetUnion(a, b), c))
+	fmt.Println(MultiSetUnion(a, MultiSetIntersect(b, c)))
+	fmt.Println(MultiSetIntersect(MultiSetUnion(a, b), c))
+	// Output:
+	// a MULTISET EXCEPT (b MULTISET UNION c)
+	// (a MULTISET UNION b) MULTISET EXCEPT c
+	// a MULTISET UNION b MULTISET UNION c
+	// a MULTISET UNION b MULTISET INTERSECT c
+	// (a MULTISET UNION b) MULTISET INTERSECT c
+}
+
+func ExampleMemberOf() {
+	tags := Column("tags")
+
+	fmt.Println(MemberOf("go", tags))
+	fmt.Println(NotMemberOf("go", tags))
+	fmt.Println(SubMultiSetOf(MultiSet("go", "sql"), tags))
+	fmt.Println(NotSubMultiSetOf(MultiSet("go"), tags))
+	fmt.Println(IsASet(tags))
+	fmt.Println(IsNotASet(tags))
+	// Output:
+	// 'go' MEMBER OF tags
+	// 'go' NOT MEMBER OF tags
+	// MULTISET['go', 'sql'] SUBMULTISET OF tags
+	// MULTISET['go'] NOT SUBMULTISET OF tags
+	// tags IS A SET
+	// tags IS NOT A SET
+}
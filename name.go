@@ -2,10 +2,22 @@ package xql
 
 import (
 	"fmt"
+	"strings"
 )
 
 const NameDataLen = 64
 
+// qualifiedIdent escapes each part of the dot separated name on its own.
+func qualifiedIdent(name fmt.Stringer) AcceptFunc {
+	return func(v Visitor) Visitor {
+		for i, part := range strings.Split(name.String(), ".") {
+			v.If(i > 0, Token('.')).Ident(Raw(part))
+		}
+
+		return v
+	}
+}
+
 type Catalog string
 
 func (c Catalog) Schema(schema string) *SchemaName {
@@ -44,7 +56,7 @@ func (n *SchemaQualifiedName) LocalOrSchemaQName() *LocalOrSchemaQualifiedName {
 func (n *SchemaQualifiedName) Join(name string) *SchemaQualifiedName {
 	return &SchemaQualifiedName{n.SchemaName, n.Name + "." + name}
 }
-func (n *SchemaQualifiedName) Accept(v Visitor) Visitor { return v.Ident(n) }
+func (n *SchemaQualifiedName) Accept(v Visitor) Visitor { return v.Visit(qualifiedIdent(n)) }
 
 type ToSchemaQualifiedName interface {
 	~string | *SchemaQualifiedName
@@ -78,7 +90,7 @@ type LocalOrSchemaQualifiedName Either[*LocalQualifiedName, *SchemaQualifiedName
 
 func (n *LocalOrSchemaQualifiedName) expr() Expr               { return n }
 func (n *LocalOrSchemaQualifiedName) tableRef() TableRef       { return (*TableName)(n) }
-func (n *LocalOrSchemaQualifiedName) Accept(v Visitor) Visitor { return v.Ident(n) }
+func (n *LocalOrSchemaQualifiedName) Accept(v Visitor) Visitor { return v.Visit(qualifiedIdent(n)) }
 func (n *LocalOrSchemaQualifiedName) String() string {
 	return (*Either[*LocalQualifiedName, *SchemaQualifiedName])(n).String()
 }
@@ -139,7 +151,7 @@ func (l *LocalQualifiedName) LocalOrSchemaQName() *LocalOrSchemaQualifiedName {
 	return &n
 }
 
-func (n *LocalQualifiedName) Accept(v Visitor) Visitor { return v.Ident(n) }
+func (n *LocalQualifiedName) Accept(v Visitor) Visitor { return v.Visit(qualifiedIdent(n)) }
 
 func (n *LocalQualifiedName) String() string {
 	if n.LocalQualifier != nil {
@@ -0,0 +1,20 @@
+package xql_test
+
+import (
+	"fmt"
+
+	. "github.com/flier/xql"
+)
+
+func ExampleQName() {
+	s := Select(Asterisk).From(QName("my schema.orders").As("o")).Where(Eq(Raw("o.id"), 1))
+
+	for _, d := range []Dialect{PostgreSQL, MySQL, SQLServer, Oracle} {
+		fmt.Printf("%s: %s\n", d, d.XQL(s))
+	}
+	// Output:
+	// PostgreSQL: SELECT * FROM "my schema".orders AS o WHERE o.id = 1
+	// MySQL: SELECT * FROM `my schema`.orders AS o WHERE o.id = 1
+	// SQL Server: SELECT * FROM "my schema".orders AS o WHERE o.id = 1
+	// Oracle: SELECT * FROM "my schema".orders o WHERE o.id = 1
+}
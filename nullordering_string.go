@@ -8,13 +8,14 @@ func _() {
 	// An "invalid array index" compiler error signifies that the constant values have changed.
 	// Re-run the stringer command to generate them again.
 	var x [1]struct{}
-	_ = x[NullsFirst-0]
-	_ = x[NullsLast-1]
+	_ = x[NullsDefault-0]
+	_ = x[NullsFirst-1]
+	_ = x[NullsLast-2]
 }
 
 const _NullOrdering_name = "NULLS FIRSTNULLS LAST"
 
-var _NullOrdering_index = [...]uint8{0, 11, 21}
+var _NullOrdering_index = [...]uint8{0, 0, 11, 21}
 
 func (i NullOrdering) String() string {
 	if i < 0 || i >= NullOrdering(len(_NullOrdering_index)-1) {
@@ -1,7 +1,11 @@
 package xql
 
-import "fmt"
-
+// OnlyClause restricts a table reference to the rows of the table itself, excluding the rows of its subtables.
+//
+//	<only spec> ::= ONLY <left paren> <table or query name> <right paren>
+//
+// PostgreSQL excludes the rows of the tables that inherit from the table, and takes the table without parentheses,
+// the standard rendering and Oracle keep them.
 type OnlyClause struct {
 	Table *TableName
 }
@@ -10,7 +14,9 @@ func Only[T ToTableName](name T) *OnlyClause {
 	return &OnlyClause{Table: newTableName(name)}
 }
 
-func (c *OnlyClause) targetTable() TargetTable { return c }
+func (c *OnlyClause) targetTable() TargetTable   { return c }
+func (c *OnlyClause) tableRef() TableRef         { return &TableFactor{Primary: c} }
+func (c *OnlyClause) tablePrimary() TablePrimary { return c }
 func (c *OnlyClause) applyDeleteStmt(s *DeleteStmt) {
 	switch t := s.Target.(type) {
 	case *TableName:
@@ -21,6 +27,13 @@ func (c *OnlyClause) applyDeleteStmt(s *DeleteStmt) {
 		}
 	}
 }
-func (c *OnlyClause) String() string {
-	return fmt.Sprintf("ONLY %s", c.Table)
+
+const kOnly = Keyword("ONLY")
+
+func (c *OnlyClause) Accept(v Visitor) Visitor {
+	only(v, "ONLY", PostgreSQL, Oracle)
+
+	return v.Visit(kOnly, WS).IfElse(v.Dialect() == PostgreSQL, c.Table, Paren(c.Table))
 }
+
+func (c *OnlyClause) String() string { return XQL(c) }
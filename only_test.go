@@ -0,0 +1,43 @@
+package xql_test
+
+import (
+	"fmt"
+
+	. "github.com/flier/xql"
+)
+
+func ExampleOnly() {
+	cities := CreateTable("cities",
+		Column("name", Text),
+		Column("population", Real),
+	)
+	capitals := CreateTable("capitals", Column("state", Char(2))).Under("cities")
+
+	fmt.Println(PostgreSQL.XQL(cities))
+	fmt.Println(PostgreSQL.XQL(capitals))
+
+	// all the cities, including the capitals
+	fmt.Println(PostgreSQL.XQL(Select(Column("name")).From(QName("cities"))))
+	// only the cities that are not capitals
+	fmt.Println(PostgreSQL.XQL(Select(Column("name")).From(Only("cities"))))
+	fmt.Println(PostgreSQL.XQL(Update(Only("cities")).Set(Assign("population", Raw("population * 1.1")))))
+	fmt.Println(PostgreSQL.XQL(DeleteFrom(Only("cities")).Where(Raw("population < 1000"))))
+	fmt.Println(Oracle.XQL(Select(Column("name")).From(Only("cities"))))
+
+	_, err := MySQL.Build(DeleteFrom(Only("cities")))
+	fmt.Println(err)
+	// Output:
+	// CREATE TABLE cities (
+	// 	name TEXT,
+	// 	population REAL
+	// )
+	// CREATE TABLE capitals (
+	// 	state CHAR(2)
+	// ) INHERITS (cities)
+	// SELECT name FROM cities
+	// SELECT name FROM ONLY cities
+	// UPDATE ONLY cities SET population = population * 1.1
+	// DELETE FROM ONLY cities WHERE population < 1000
+	// SELECT name FROM ONLY (cities)
+	// xql: unsupported by the dialect: ONLY
+}
@@ -48,7 +48,7 @@ func (s *SortSpec) String() string {
 		b.WriteString(s.OrderingSpec.String())
 	}
 
-	if s.NullOrdering != NullsFirst {
+	if s.NullOrdering != NullsDefault {
 		b.WriteByte(' ')
 		b.WriteString(s.NullOrdering.String())
 	}
@@ -67,9 +67,32 @@ const (
 
 //go:generate stringer -type=NullOrdering -linecomment
 
+// NullOrdering places the null values, NullsDefault leaves it to the database.
 type NullOrdering int
 
 const (
-	NullsFirst NullOrdering = iota // NULLS FIRST
-	NullsLast                      // NULLS LAST
+	NullsDefault NullOrdering = iota //
+	NullsFirst                       // NULLS FIRST
+	NullsLast                        // NULLS LAST
 )
+
+// Asc sorts by the key in ascending order.
+func Asc(key ValueExpr) *SortSpec { return &SortSpec{Key: key} }
+
+// Desc sorts by the key in descending order.
+func Desc(key ValueExpr) *SortSpec { return &SortSpec{Key: key, OrderingSpec: OrderingDesc} }
+
+// NullsFirst sorts the null values before the non-null values.
+func (s *SortSpec) NullsFirst() *SortSpec {
+	s.NullOrdering = NullsFirst
+	return s
+}
+
+// NullsLast sorts the null values after the non-null values.
+func (s *SortSpec) NullsLast() *SortSpec {
+	s.NullOrdering = NullsLast
+	return s
+}
+
+func (d *ColumnDef) sortSpec() *SortSpec { return &SortSpec{Key: d.expr()} }
+func (e Raw) sortSpec() *SortSpec        { return &SortSpec{Key: e} }
@@ -12,9 +12,9 @@ func _() {
 	_ = x[OverridingSystemValue-1]
 }
 
-const _OverridingClause_name = "OverridingUserValueOverridingSystemValue"
+const _OverridingClause_name = "OVERRIDING USER VALUEOVERRIDING SYSTEM VALUE"
 
-var _OverridingClause_index = [...]uint8{0, 19, 40}
+var _OverridingClause_index = [...]uint8{0, 21, 44}
 
 func (i OverridingClause) String() string {
 	if i < 0 || i >= OverridingClause(len(_OverridingClause_index)-1) {
@@ -0,0 +1,422 @@
+package xql
+
+import (
+	"fmt"
+)
+
+//go:generate stringer -type PartitionStrategy -linecomment
+
+// PartitionStrategy tells how the rows of a partitioned table are distributed over its partitions.
+type PartitionStrategy int
+
+const (
+	// Each partition holds a range of keys.
+	PartitionRange PartitionStrategy = iota // RANGE
+	// Each partition holds a list of keys.
+	PartitionList // LIST
+	// Each partition holds the keys whose hash has a given remainder.
+	PartitionHash // HASH
+)
+
+// PartitionSpec divides a table into partitions.
+//
+//	PARTITION BY { RANGE | LIST | HASH } ( { <column name> | ( <expression> ) } [, ...] )
+//
+// Partitioning is not part of the standard, the syntax follows PostgreSQL, whose partitions are tables of their own.
+// MySQL defines the partitions inline, the range and list partitioning on columns is rendered with COLUMNS,
+// and the hash partitioning on several columns is rendered as KEY.
+type PartitionSpec struct {
+	Strategy   PartitionStrategy
+	Keys       []ValueExpr
+	Count      int
+	Partitions []*PartitionDef
+}
+
+const (
+	kPartitions = Keyword("PARTITIONS")
+	kColumns    = Keyword("COLUMNS")
+	kKey        = Keyword("KEY")
+)
+
+func (s *PartitionSpec) Accept(v Visitor) Visitor {
+	only(v, "PARTITION BY", PostgreSQL, MySQL)
+
+	if len(s.Keys) == 0 {
+		return v.Fail(fmt.Errorf("%w: PARTITION BY %s without keys", ErrIncomplete, s.Strategy))
+	}
+
+	if v.Dialect() != MySQL {
+		if s.Count > 0 || s.Partitions != nil {
+			v.Fail(fmt.Errorf("%w: inline partition definitions", ErrUnsupported))
+		}
+
+		keys := make([]Accepter, len(s.Keys))
+		for i, k := range s.Keys {
+			if _, ok := unqualifiedColumn(k); ok {
+				keys[i] = accept(k)
+			} else {
+				keys[i] = Paren(accept(k))
+			}
+		}
+
+		return v.Visit(kPartitionBy, WS, Keyword(s.Strategy.String()), WS, Paren(Joins(keys, Sep)))
+	}
+
+	columns := true
+	for _, k := range s.Keys {
+		if _, ok := unqualifiedColumn(k); !ok {
+			columns = false
+		}
+	}
+
+	if s.Strategy != PartitionHash && s.Partitions == nil {
+		return v.Fail(fmt.Errorf("%w: PARTITION BY %s without partition definitions", ErrIncomplete, s.Strategy))
+	}
+
+	v.Visit(kPartitionBy, WS)
+
+	switch {
+	case s.Strategy == PartitionHash && len(s.Keys) > 1 && columns:
+		v.Visit(kKey)
+	case s.Strategy == PartitionHash && len(s.Keys) > 1:
+		v.Fail(fmt.Errorf("%w: PARTITION BY HASH on several expressions", ErrUnsupported))
+	case s.Strategy != PartitionHash && columns:
+		v.Visit(Keyword(s.Strategy.String()), WS, kColumns)
+	default:
+		v.Visit(Keyword(s.Strategy.String()))
+	}
+
+	v.Visit(WS, Paren(Joins(accepts(s.Keys), Sep))).If(s.Count > 0, WS, kPartitions, WS, Int(s.Count))
+
+	if s.Partitions != nil {
+		v.Raw(" (\n\t").Visit(Joins(s.Partitions, Raw(",\n\t"))).Raw("\n)")
+	}
+
+	return v
+}
+
+func (s *PartitionSpec) String() string { return XQL(s) }
+
+func (t *TableDef) partitionBy(strategy PartitionStrategy, keys []ToSortSpec) *PartitionByStep {
+	s := &PartitionSpec{Strategy: strategy}
+
+	for _, k := range keys {
+		s.Keys = append(s.Keys, k.sortSpec().Key)
+	}
+
+	t.Partitioning = s
+
+	return &PartitionByStep{t, s}
+}
+
+// PartitionByRange divides the table into partitions holding ranges of the keys, a key is a column or an expression.
+func (t *TableDef) PartitionByRange(keys ...ToSortSpec) *PartitionByStep {
+	return t.partitionBy(PartitionRange, keys)
+}
+
+// PartitionByList divides the table into partitions holding lists of the keys, a key is a column or an expression.
+func (t *TableDef) PartitionByList(keys ...ToSortSpec) *PartitionByStep {
+	return t.partitionBy(PartitionList, keys)
+}
+
+// PartitionByHash divides the table into partitions by the hash of the keys, a key is a column or an expression.
+func (t *TableDef) PartitionByHash(keys ...ToSortSpec) *PartitionByStep {
+	return t.partitionBy(PartitionHash, keys)
+}
+
+// PartitionByStep defines the partitions of a partitioned table inline, as MySQL does.
+type PartitionByStep struct {
+	*TableDef
+
+	Spec *PartitionSpec
+}
+
+// Partitions sets the number of partitions, the partitions are named by the database.
+// MySQL only names the hash partitions, the range and list partitions need their definitions.
+func (s *PartitionByStep) Partitions(n int) *TableDef {
+	s.Spec.Count = n
+	return s.TableDef
+}
+
+// Partition defines the partitions of the table.
+func (s *PartitionByStep) Partition(x ...*PartitionDef) *TableDef {
+	s.Spec.Partitions = append(s.Spec.Partitions, x...)
+	return s.TableDef
+}
+
+// PartitionDef defines a partition inline, with the keys it holds.
+//
+//	PARTITION <partition name> [ VALUES { LESS THAN ( <value> [, ...] ) | IN ( <value> [, ...] ) } ]
+type PartitionDef struct {
+	Name  string
+	Bound *PartitionBound
+}
+
+// Partition defines a partition with the keys it holds, the keys of a hash partition are left nil.
+func Partition(name string, bound *PartitionBound) *PartitionDef {
+	return &PartitionDef{name, bound}
+}
+
+const kPartition = Keyword("PARTITION")
+
+func (d *PartitionDef) Accept(v Visitor) Visitor {
+	return v.Visit(kPartition, WS, Ident(Raw(d.Name))).IfNotNil(d.Bound, WS, d.Bound)
+}
+
+func (d *PartitionDef) String() string { return XQL(d) }
+
+// PartitionBound is the set of keys a partition holds.
+//
+//	<partition bound spec> ::=
+//		  FOR VALUES FROM ( <value> [, ...] ) TO ( <value> [, ...] )
+//		| FOR VALUES IN ( <value> [, ...] )
+//		| FOR VALUES WITH ( MODULUS <integer>, REMAINDER <integer> )
+//		| DEFAULT
+//
+// MySQL bounds a range partition by the keys LESS THAN the given values, from the bound of the previous partition.
+type PartitionBound struct {
+	From      []any
+	UpTo      []any
+	LessThan  []any
+	In        []any
+	Modulus   int
+	Remainder int
+	Default   bool
+}
+
+var (
+	// MinValueBound is a range bound lower than any value of the key, rendered as MINVALUE.
+	MinValueBound Accepter = kMinValue
+	// MaxValueBound is a range bound greater than any value of the key, rendered as MAXVALUE.
+	MaxValueBound Accepter = kMaxValue
+)
+
+// DefaultPartition holds the keys no other partition holds.
+func DefaultPartition() *PartitionBound {
+	return &PartitionBound{Default: true}
+}
+
+// ValuesFrom bounds a range partition from the values, inclusive.
+func ValuesFrom(x ...any) *PartitionBound {
+	return &PartitionBound{From: x}
+}
+
+// To bounds a range partition to the values, exclusive.
+func (b *PartitionBound) To(x ...any) *PartitionBound {
+	b.UpTo = x
+	return b
+}
+
+// ValuesLessThan bounds a range partition to the values, exclusive.
+func ValuesLessThan(x ...any) *PartitionBound {
+	return &PartitionBound{LessThan: x}
+}
+
+// ValuesIn lists the keys held by a list partition.
+func ValuesIn(x ...any) *PartitionBound {
+	return &PartitionBound{In: x}
+}
+
+// ValuesWith holds the keys whose hash modulo the modulus is the remainder.
+func ValuesWith(modulus, remainder int) *PartitionBound {
+	return &PartitionBound{Modulus: modulus, Remainder: remainder}
+}
+
+const (
+	kForValues = Keyword("FOR VALUES")
+	kLessThan  = Keyword("LESS THAN")
+	kModulus   = Keyword("MODULUS")
+	kRemainder = Keyword("REMAINDER")
+)
+
+func (b *PartitionBound) Accept(v Visitor) Visitor {
+	if v.Dialect() == MySQL {
+		switch {
+		case b.LessThan != nil:
+			return v.Visit(kValues, WS, kLessThan, WS, boundValues(b.LessThan))
+		case b.In != nil:
+			return v.Visit(kValues, WS, kIn, WS, boundValues(b.In))
+		default:
+			return v.Fail(fmt.Errorf("%w: partition bound %s", ErrUnsupported, StandardSQL.XQL(b)))
+		}
+	}
+
+	only(v, "partition bound", PostgreSQL)
+
+	switch {
+	case b.Default:
+		return v.Visit(kDefault)
+	case b.LessThan != nil:
+		return v.Fail(fmt.Errorf("%w: VALUES LESS THAN", ErrUnsupported))
+	case b.In != nil:
+		return v.Visit(kForValues, WS, kIn, WS, boundValues(b.In))
+	case b.Modulus > 0:
+		return v.Visit(kForValues, WS, kWith, WS,
+			Paren(kModulus, WS, Int(b.Modulus), Sep, kRemainder, WS, Int(b.Remainder)))
+	case b.From == nil || b.UpTo == nil:
+		return v.Fail(fmt.Errorf("%w: FOR VALUES FROM ... TO without values", ErrIncomplete))
+	default:
+		return v.Visit(kForValues, WS, kFrom, WS, boundValues(b.From), WS, kTo, WS, boundValues(b.UpTo))
+	}
+}
+
+func (b *PartitionBound) String() string { return XQL(b) }
+
+// boundValues renders the values of a partition bound.
+func boundValues(x []any) Accepter {
+	values := make([]Accepter, len(x))
+
+	for i, value := range x {
+		switch value := value.(type) {
+		case Accepter:
+			values[i] = value
+		default:
+			values[i] = accept(newTypedRowValueExpr(value))
+		}
+	}
+
+	return Paren(Joins(values, Sep))
+}
+
+// PartitionOfClause creates a table as a partition of a partitioned table.
+//
+//	PARTITION OF <parent table> { FOR VALUES <partition bound spec> | DEFAULT }
+type PartitionOfClause struct {
+	Parent *TableName
+	Bound  *PartitionBound
+}
+
+// PartitionOf creates the table as a partition of the parent table, holding the keys of the bound.
+func (t *TableDef) PartitionOf(parent string, bound *PartitionBound) *TableDef {
+	t.Content = &PartitionOfClause{newTableName(parent), bound}
+	return t
+}
+
+const kPartitionOf = Keyword("PARTITION OF")
+
+func (c *PartitionOfClause) tableContentSource() TableContentSource { return c }
+func (c *PartitionOfClause) applyTableDef(t *TableDef)              { t.Content = c }
+func (c *PartitionOfClause) Accept(v Visitor) Visitor {
+	only(v, "CREATE TABLE ... PARTITION OF", PostgreSQL)
+
+	if c.Bound == nil {
+		return v.Fail(fmt.Errorf("%w: PARTITION OF without the bound", ErrIncomplete))
+	}
+
+	return v.Visit(kPartitionOf, WS, c.Parent, WS, c.Bound)
+}
+func (c *PartitionOfClause) String() string { return XQL(c) }
+
+// AttachPartitionAction attaches an existing table as a partition of the table.
+//
+//	ATTACH PARTITION <table name> { FOR VALUES <partition bound spec> | DEFAULT }
+type AttachPartitionAction struct {
+	Name  *TableName
+	Bound *PartitionBound
+}
+
+// DetachPartitionAction detaches a partition of the table, which becomes a standalone table.
+//
+//	DETACH PARTITION <table name> [ CONCURRENTLY ]
+type DetachPartitionAction struct {
+	Name       *TableName
+	Concurrent bool
+}
+
+// AddPartitionAction adds partitions to the table, as MySQL does.
+//
+//	ADD PARTITION ( <partition definition> [, ...] )
+type AddPartitionAction struct {
+	Partitions []*PartitionDef
+}
+
+// DropPartitionAction drops partitions of the table with their rows, as MySQL does.
+//
+//	DROP PARTITION <partition name> [, ...]
+type DropPartitionAction struct {
+	Names []string
+}
+
+// AttachPartition attaches an existing table as a partition of the table, holding the keys of the bound.
+func (s *AlterTableStmt) AttachPartition(name string, bound *PartitionBound) *AlterTableStmt {
+	return s.action(&AttachPartitionAction{newTableName(name), bound})
+}
+
+// DetachPartition detaches a partition of the table.
+func (s *AlterTableStmt) DetachPartition(name string) *AlterTableStmt {
+	return s.action(&DetachPartitionAction{Name: newTableName(name)})
+}
+
+// DetachPartitionConcurrently detaches a partition of the table without blocking concurrent queries.
+func (s *AlterTableStmt) DetachPartitionConcurrently(name string) *AlterTableStmt {
+	return s.action(&DetachPartitionAction{newTableName(name), true})
+}
+
+// AddPartition adds partitions to the table.
+func (s *AlterTableStmt) AddPartition(x ...*PartitionDef) *AlterTableStmt {
+	return s.action(&AddPartitionAction{x})
+}
+
+// DropPartition drops partitions of the table.
+func (s *AlterTableStmt) DropPartition(names ...string) *AlterTableStmt {
+	return s.action(&DropPartitionAction{names})
+}
+
+const (
+	kAttachPartition = Keyword("ATTACH PARTITION")
+	kDetachPartition = Keyword("DETACH PARTITION")
+	kAddPartition    = Keyword("ADD PARTITION")
+	kDropPartition   = Keyword("DROP PARTITION")
+)
+
+func (a *AttachPartitionAction) alterTableAction() AlterTableAction { return a }
+func (a *AttachPartitionAction) Accept(v Visitor) Visitor {
+	only(v, "ATTACH PARTITION", PostgreSQL)
+
+	if a.Bound == nil {
+		return v.Fail(fmt.Errorf("%w: ATTACH PARTITION without the bound", ErrIncomplete))
+	}
+
+	return v.Visit(kAttachPartition, WS, a.Name, WS, a.Bound)
+}
+func (a *AttachPartitionAction) String() string { return XQL(a) }
+
+func (a *DetachPartitionAction) alterTableAction() AlterTableAction { return a }
+func (a *DetachPartitionAction) Accept(v Visitor) Visitor {
+	only(v, "DETACH PARTITION", PostgreSQL)
+
+	return v.Visit(kDetachPartition, WS, a.Name).If(a.Concurrent, WS, kConcurrently)
+}
+func (a *DetachPartitionAction) String() string { return XQL(a) }
+
+func (a *AddPartitionAction) alterTableAction() AlterTableAction { return a }
+func (a *AddPartitionAction) Accept(v Visitor) Visitor {
+	only(v, "ADD PARTITION", MySQL)
+
+	return v.Visit(kAddPartition, WS, Paren(Joins(a.Partitions, Sep)))
+}
+func (a *AddPartitionAction) String() string { return XQL(a) }
+
+func (a *DropPartitionAction) alterTableAction() AlterTableAction { return a }
+func (a *DropPartitionAction) Accept(v Visitor) Visitor {
+	only(v, "DROP PARTITION", MySQL)
+
+	names := make([]Accepter, len(a.Names))
+	for i, name := range a.Names {
+		names[i] = Ident(Raw(name))
+	}
+
+	return v.Visit(kDropPartition, WS, Joins(names, Sep))
+}
+func (a *DropPartitionAction) String() string { return XQL(a) }
+
+// isPartitioning tells whether the action changes the partitions of the table,
+// which is done in a statement of its own.
+func isPartitioning(a AlterTableAction) bool {
+	switch a.(type) {
+	case *AttachPartitionAction, *DetachPartitionAction, *AddPartitionAction, *DropPartitionAction:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,145 @@
+package xql_test
+
+import (
+	"fmt"
+
+	. "github.com/flier/xql"
+)
+
+func ExampleTableDef_PartitionByRange() {
+	events := CreateTable("events",
+		Column("tenant_id", Integer),
+		Column("created_at", Timestamp),
+		Column("payload", Text),
+	).PartitionByRange(Column("created_at"))
+
+	fmt.Println(PostgreSQL.XQL(events))
+	fmt.Println(PostgreSQL.XQL(CreateTable("events_2024").PartitionOf("events", ValuesFrom("2024-01-01").To("2025-01-01"))))
+	fmt.Println(PostgreSQL.XQL(CreateTable("events_old").PartitionOf("events", ValuesFrom(MinValueBound).To("2024-01-01"))))
+	fmt.Println(PostgreSQL.XQL(CreateTable("events_other").PartitionOf("events", DefaultPartition())))
+
+	fmt.Println(MySQL.XQL(CreateTable("employees",
+		Column("id", Integer),
+		Column("hired", Date),
+	).PartitionByRange(Raw("YEAR(hired)")).Partition(
+		Partition("p0", ValuesLessThan(1991)),
+		Partition("p1", ValuesLessThan(2001)),
+		Partition("p2", ValuesLessThan(MaxValueBound)),
+	)))
+
+	_, err := SQLServer.Build(events)
+	fmt.Println(err)
+	_, err = MySQL.Build(CreateTable("events_2024").PartitionOf("events", ValuesFrom("2024-01-01").To("2025-01-01")))
+	fmt.Println(err)
+	_, err = PostgreSQL.Build(CreateTable("events_2024").PartitionOf("events", ValuesFrom("2024-01-01")))
+	fmt.Println(err)
+	_, err = PostgreSQL.Build(CreateTable("events_2024").PartitionOf("events", nil))
+	fmt.Println(err)
+	_, err = PostgreSQL.Build(CreateTable("events", Column("created_at", Timestamp)).PartitionByRange())
+	fmt.Println(err)
+	_, err = MySQL.Build(CreateTable("employees", Column("hired", Date)).PartitionByRange(Raw("YEAR(hired)")).Partitions(4))
+	fmt.Println(err)
+	// Output:
+	// CREATE TABLE events (
+	// 	tenant_id INTEGER,
+	// 	created_at TIMESTAMP,
+	// 	payload TEXT
+	// ) PARTITION BY RANGE (created_at)
+	// CREATE TABLE events_2024 PARTITION OF events FOR VALUES FROM ('2024-01-01') TO ('2025-01-01')
+	// CREATE TABLE events_old PARTITION OF events FOR VALUES FROM (MINVALUE) TO ('2024-01-01')
+	// CREATE TABLE events_other PARTITION OF events DEFAULT
+	// CREATE TABLE employees (
+	// 	id INTEGER,
+	// 	hired DATE
+	// ) PARTITION BY RANGE (YEAR(hired)) (
+	// 	PARTITION p0 VALUES LESS THAN (1991),
+	// 	PARTITION p1 VALUES LESS THAN (2001),
+	// 	PARTITION p2 VALUES LESS THAN (MAXVALUE)
+	// )
+	// xql: unsupported by the dialect: PARTITION BY
+	// xql: unsupported by the dialect: CREATE TABLE ... PARTITION OF
+	// xql: incomplete statement: FOR VALUES FROM ... TO without values
+	// xql: incomplete statement: PARTITION OF without the bound
+	// xql: incomplete statement: PARTITION BY RANGE without keys
+	// xql: incomplete statement: PARTITION BY RANGE without partition definitions
+}
+
+func ExampleTableDef_PartitionByList() {
+	fmt.Println(PostgreSQL.XQL(CreateTable("orders",
+		Column("id", Integer),
+		Column("region", Text),
+	).PartitionByList(Column("region"))))
+	fmt.Println(PostgreSQL.XQL(CreateTable("orders_eu").PartitionOf("orders", ValuesIn("de", "fr"))))
+
+	fmt.Println(MySQL.XQL(CreateTable("stores",
+		Column("id", Integer),
+		Column("store_id", Integer),
+	).PartitionByList(Column("store_id")).Partition(
+		Partition("east", ValuesIn(1, 2, 3)),
+		Partition("west", ValuesIn(4, 5, 6)),
+	)))
+	// Output:
+	// CREATE TABLE orders (
+	// 	id INTEGER,
+	// 	region TEXT
+	// ) PARTITION BY LIST (region)
+	// CREATE TABLE orders_eu PARTITION OF orders FOR VALUES IN ('de', 'fr')
+	// CREATE TABLE stores (
+	// 	id INTEGER,
+	// 	store_id INTEGER
+	// ) PARTITION BY LIST COLUMNS (store_id) (
+	// 	PARTITION east VALUES IN (1, 2, 3),
+	// 	PARTITION west VALUES IN (4, 5, 6)
+	// )
+}
+
+func ExampleTableDef_PartitionByHash() {
+	fmt.Println(PostgreSQL.XQL(CreateTable("events",
+		Column("tenant_id", Integer),
+		Column("payload", Text),
+	).PartitionByHash(Column("tenant_id"))))
+	fmt.Println(PostgreSQL.XQL(CreateTable("events_p0").PartitionOf("events", ValuesWith(4, 0))))
+	fmt.Println(PostgreSQL.XQL(CreateTable("events_p1").PartitionOf("events", ValuesWith(4, 1)).
+		PartitionByRange(Raw("date_trunc('day', created_at)"))))
+
+	fmt.Println(MySQL.XQL(CreateTable("events", Column("tenant_id", Integer)).PartitionByHash(Column("tenant_id")).Partitions(4)))
+	fmt.Println(MySQL.XQL(CreateTable("events", Column("a", Integer), Column("b", Integer)).PartitionByHash(Column("a"), Column("b")).Partitions(8)))
+
+	_, err := PostgreSQL.Build(CreateTable("events", Column("tenant_id", Integer)).PartitionByHash(Column("tenant_id")).Partitions(4))
+	fmt.Println(err)
+	// Output:
+	// CREATE TABLE events (
+	// 	tenant_id INTEGER,
+	// 	payload TEXT
+	// ) PARTITION BY HASH (tenant_id)
+	// CREATE TABLE events_p0 PARTITION OF events FOR VALUES WITH (MODULUS 4, REMAINDER 0)
+	// CREATE TABLE events_p1 PARTITION OF events FOR VALUES WITH (MODULUS 4, REMAINDER 1) PARTITION BY RANGE ((date_trunc('day', created_at)))
+	// CREATE TABLE events (
+	// 	tenant_id INTEGER
+	// ) PARTITION BY HASH (tenant_id) PARTITIONS 4
+	// CREATE TABLE events (
+	// 	a INTEGER,
+	// 	b INTEGER
+	// ) PARTITION BY KEY (a, b) PARTITIONS 8
+	// xql: unsupported by the dialect: inline partition definitions
+}
+
+func ExampleAlterTableStmt_AttachPartition() {
+	fmt.Println(PostgreSQL.XQL(AlterTable("events").
+		AttachPartition("events_2025", ValuesFrom("2025-01-01").To("2026-01-01")).
+		DetachPartitionConcurrently("events_2023")))
+	fmt.Println(PostgreSQL.XQL(AlterTable("events").AttachPartition("events_other", DefaultPartition())))
+	fmt.Println(MySQL.XQL(AlterTable("employees").
+		AddPartition(Partition("p3", ValuesLessThan(2011))).
+		DropPartition("p0", "p1")))
+
+	_, err := MySQL.Build(AlterTable("events").DetachPartition("events_2023"))
+	fmt.Println(err)
+	// Output:
+	// ALTER TABLE events ATTACH PARTITION events_2025 FOR VALUES FROM ('2025-01-01') TO ('2026-01-01');
+	// ALTER TABLE events DETACH PARTITION events_2023 CONCURRENTLY
+	// ALTER TABLE events ATTACH PARTITION events_other DEFAULT
+	// ALTER TABLE employees ADD PARTITION (PARTITION p3 VALUES LESS THAN (2011));
+	// ALTER TABLE employees DROP PARTITION p0, p1
+	// xql: unsupported by the dialect: DETACH PARTITION
+}
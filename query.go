@@ -71,3 +71,14 @@ func (s *QuerySet) String() string {
 }
 
 type QueryPrimary interface{}
+
+// SubQuery is a parenthesized query expression used as a value.
+//
+//	<subquery> ::= <left paren> <query expression> <right paren>
+type SubQuery struct {
+	Query QueryExprBody
+}
+
+func (q *SubQuery) expr() Expr               { return q }
+func (q *SubQuery) Accept(v Visitor) Visitor { return v.Visit(Paren(accept(q.Query))) }
+func (q *SubQuery) String() string           { return XQL(q) }
@@ -0,0 +1,96 @@
+package xql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReturningClause returns the rows modified by a data change statement.
+//
+// It is rendered as RETURNING on PostgreSQL and SQLite, as RETURNING ... INTO on Oracle,
+// and as OUTPUT on SQL Server, where the unqualified columns refer to the INSERTED or DELETED rows.
+type ReturningClause struct {
+	List SelectList
+	Into []VarName
+}
+
+func Returning(x ...SelectFieldOrAsterisk) *ReturningClause {
+	var l SelectList
+
+	for _, f := range x {
+		l = f.applySelectList(l)
+	}
+
+	return &ReturningClause{List: l}
+}
+
+const (
+	kReturning = Keyword("RETURNING")
+	kOutput    = Keyword("OUTPUT")
+	kInserted  = Keyword("INSERTED")
+	kDeleted   = Keyword("DELETED")
+)
+
+func (c *ReturningClause) Accept(v Visitor) Visitor {
+	if c.List == nil {
+		return v.Fail(fmt.Errorf("%w: RETURNING without columns", ErrIncomplete))
+	}
+
+	switch v.Dialect() {
+	case MySQL:
+		return v.Fail(fmt.Errorf("%w: RETURNING", ErrUnsupported))
+	case SQLServer:
+		return v.Visit(kOutput, WS, c.List)
+	case Oracle:
+		if len(c.Into) == 0 {
+			return v.Fail(fmt.Errorf("%w: RETURNING without INTO", ErrUnsupported))
+		}
+	}
+
+	return v.Visit(kReturning, WS, c.List).
+		If(len(c.Into) > 0, WS, kInto, WS, Raw(strings.Join(c.Into, ", ")))
+}
+
+func (c *ReturningClause) String() string { return XQL(c) }
+
+// output renders the OUTPUT clause of SQL Server, whose unqualified columns are qualified by the pseudo table.
+func (c *ReturningClause) output(table Keyword) Accepter {
+	if c.List == nil {
+		return c
+	}
+
+	l, ok := c.List.(SelectSubLists)
+	if !ok {
+		return &ReturningClause{List: SelectSubLists{Raw(table + ".*").selectSubList()}}
+	}
+
+	q := make(SelectSubLists, len(l))
+
+	for i, s := range l {
+		if name, ok := unqualifiedColumn(s.Value); ok {
+			q[i] = &SelectSubList{&columnRef{table, name}, s.As}
+		} else {
+			q[i] = s
+		}
+	}
+
+	return &ReturningClause{List: q}
+}
+
+// unqualifiedColumn returns the name of the column which isn't qualified by a table.
+func unqualifiedColumn(x ValueExpr) (ColumnName, bool) {
+	switch x.(type) {
+	case Raw, *ColumnExpr, *LocalOrSchemaQualifiedName, *SchemaQualifiedName:
+		s := x.String()
+
+		for i, r := range s {
+			if !(r == '_' || 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || i > 0 && '0' <= r && r <= '9') {
+				return "", false
+			}
+		}
+
+		return s, len(s) > 0
+	}
+
+	return "", false
+}
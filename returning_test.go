@@ -0,0 +1,36 @@
+package xql_test
+
+import (
+	"fmt"
+
+	. "github.com/flier/xql"
+)
+
+func ExampleReturning() {
+	insert := InsertInto("users").Columns("name", "email").Values("alice", "alice@example.com").Returning(Raw("id"))
+	update := Update("users").Set(Assign("active", false)).Where(Raw("last_login < '2020-01-01'")).Returning(Raw("id"), Raw("name"))
+	remove := DeleteFrom("sessions").Where(Raw("expired")).Returning(Asterisk)
+
+	for _, d := range []Dialect{PostgreSQL, SQLServer} {
+		fmt.Println(d.XQL(insert))
+		fmt.Println(d.XQL(update))
+		fmt.Println(d.XQL(remove))
+	}
+
+	fmt.Println(Oracle.XQL(InsertInto("users").Columns("name").Values("bob").Returning(Raw("id")).ReturningInto(":id")))
+
+	_, err := MySQL.Build(insert)
+	fmt.Println(err)
+	_, err = Oracle.Build(DeleteFrom("sessions").Where(Raw("expired")).ReturningInto(":id"))
+	fmt.Println(err)
+	// Output:
+	// INSERT INTO users (name, email) VALUES ('alice', 'alice@example.com') RETURNING id
+	// UPDATE users SET active = false WHERE last_login < '2020-01-01' RETURNING id, name
+	// DELETE FROM sessions WHERE expired RETURNING *
+	// INSERT INTO users (name, email) OUTPUT INSERTED.id VALUES ('alice', 'alice@example.com')
+	// UPDATE users SET active = false OUTPUT INSERTED.id, INSERTED.name WHERE last_login < '2020-01-01'
+	// DELETE FROM sessions OUTPUT DELETED.* WHERE expired
+	// INSERT INTO users (name) VALUES ('bob') RETURNING id INTO :id
+	// xql: unsupported by the dialect: RETURNING
+	// xql: incomplete statement: RETURNING without columns
+}
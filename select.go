@@ -35,12 +35,19 @@ func (s *SelectStmt) expr() *TableExpr {
 	return s.TableExpr
 }
 
-var kSelect = Keyword("SELECT")
+var (
+	kSelect = Keyword("SELECT")
+	kInto   = Keyword("INTO")
+)
+
+func (s *SelectStmt) Query() *SelectStmt { return s }
 
 func (s *SelectStmt) Accept(v Visitor) Visitor {
 	return v.Keyword(kSelect).
 		IfNotNil(s.Quantifier, WS, Stringer(s.Quantifier)).
-		Visit(WS, s.Select)
+		Visit(WS, s.Select).
+		IfNotNil(s.TableExpr, WS, s.TableExpr).
+		IfNotNil(s.Into, WS, kInto, WS, Stringer(s.Into))
 }
 
 func (s *SelectStmt) String() string {
@@ -65,6 +72,16 @@ func (s *SelectStmt) String() string {
 	return b.String()
 }
 
+// SelectQuery is implemented by the SELECT statement and every step of its builder.
+type SelectQuery interface {
+	Query() *SelectStmt
+}
+
+var (
+	_ SelectQuery = &SelectStmt{}
+	_ SelectQuery = &SelectFinalStep{}
+)
+
 type ToSelectList interface {
 	selectList() SelectList
 }
@@ -98,7 +115,7 @@ func (l SelectSubLists) String() string         { return Join(l, ", ") }
 func (l SelectSubLists) Accept(v Visitor) Visitor {
 	for i, s := range l {
 		if i > 0 {
-			v.Sep()
+			v.Sep().WS()
 		}
 
 		s.Accept(v)
@@ -161,7 +178,7 @@ func (l *SelectSubList) selectSubList() *SelectSubList           { return l }
 func (l *SelectSubList) applySelectList(s SelectList) SelectList { return appendSelectList(s, l) }
 
 func (l *SelectSubList) Accept(v Visitor) Visitor {
-	return v.Raw(l.Value.String()).If(len(l.As) > 0, WS, Stringer(l.As))
+	return v.Visit(accept(l.Value)).If(len(l.As) > 0, WS, Stringer(l.As))
 }
 
 func (l *SelectSubList) String() string {
@@ -220,52 +237,23 @@ func (e *TableExpr) forLock() *ForLockClause {
 	return e.ForLock
 }
 
-func (e *TableExpr) String() string {
-	var b strings.Builder
-
-	if e.From != nil {
-		b.WriteString(e.From.String())
-	}
-
-	if e.Where != nil {
-		fmt.Fprintf(&b, " %s", e.Where)
-	}
-
-	if e.GroupBy != nil {
-		fmt.Fprintf(&b, " %s", e.GroupBy)
-	}
-
-	if e.Having != nil {
-		fmt.Fprintf(&b, " %s", e.Having)
-	}
-
-	if e.Window != nil {
-		fmt.Fprintf(&b, " %s", e.Window)
-	}
-
-	if e.OrderBy != nil {
-		fmt.Fprintf(&b, " %s", e.OrderBy)
-	}
-
-	if e.Limits != nil {
-		fmt.Fprintf(&b, " %s", e.Limits)
-	}
-
-	if e.ForLock != nil {
-		fmt.Fprintf(&b, " %s", e.ForLock)
-	}
-
-	if e.WithCheckOption {
-		fmt.Fprintf(&b, " WITH CHECK OPTION")
-	}
-
-	if e.WithReadOnly {
-		fmt.Fprintf(&b, " WITH READ ONLY")
-	}
-
-	if len(e.Option) > 0 {
-		fmt.Fprintf(&b, " %s", e.Option)
-	}
+const (
+	kWithCheckOption = Keyword("WITH CHECK OPTION")
+	kWithReadOnly    = Keyword("WITH READ ONLY")
+)
 
-	return b.String()
+func (e *TableExpr) Accept(v Visitor) Visitor {
+	return v.IfNotNil(e.From, e.From).
+		IfNotNil(e.Where, WS, e.Where).
+		IfNotNil(e.GroupBy, WS, accept(e.GroupBy)).
+		IfNotNil(e.Having, WS, e.Having).
+		IfNotNil(e.Window, WS, accept(e.Window)).
+		IfNotNil(e.OrderBy, WS, accept(e.OrderBy)).
+		IfNotNil(e.Limits, WS, accept(e.Limits)).
+		IfNotNil(e.ForLock, WS, accept(e.ForLock)).
+		If(e.WithCheckOption, WS, kWithCheckOption).
+		If(e.WithReadOnly, WS, kWithReadOnly).
+		If(len(e.Option) > 0, WS, Raw(e.Option))
 }
+
+func (e *TableExpr) String() string { return XQL(e) }
@@ -13,8 +13,9 @@ func (s *SelectFinalStep) stmt() *SelectStmt {
 	return s.Stmt
 }
 
-func (s *SelectFinalStep) Query() *SelectStmt { return s.Stmt }
-func (s *SelectFinalStep) String() string     { return s.Stmt.String() }
+func (s *SelectFinalStep) Query() *SelectStmt       { return s.Stmt }
+func (s *SelectFinalStep) Accept(v Visitor) Visitor { return s.Stmt.Accept(v) }
+func (s *SelectFinalStep) String() string           { return s.Stmt.String() }
 
 type SelectUnionStep struct {
 	SelectFinalStep
@@ -92,13 +93,15 @@ func (s *SelectForUpdateStep) ForKeyShare() *SelectForUpdateOfStep {
 	return &SelectForUpdateOfStep{SelectForUpdateWaitStep{s.SelectForStep}}
 }
 
-func (s *SelectForUpdateStep) withCheckOption() *SelectFinalStep {
+// WithCheckOption prohibits changes through the subquery that would produce rows it does not select.
+func (s *SelectForUpdateStep) WithCheckOption() *SelectFinalStep {
 	s.stmt().expr().WithCheckOption = true
 
 	return &s.SelectFinalStep
 }
 
-func (s *SelectForUpdateStep) withReadOnly() *SelectFinalStep {
+// WithReadOnly prohibits changes through the subquery.
+func (s *SelectForUpdateStep) WithReadOnly() *SelectFinalStep {
 	s.stmt().expr().WithReadOnly = true
 
 	return &s.SelectFinalStep
@@ -280,4 +283,5 @@ var (
 	_ SelectFieldOrAsterisk = &CallExpr{}
 	_ SelectFieldOrAsterisk = &ColumnDef{}
 	_ SelectFieldOrAsterisk = &SelectSubList{}
+	_ SelectFieldOrAsterisk = MergeAction
 )
@@ -30,7 +30,7 @@ const kStartWith = Keyword("START WITH")
 
 func (o SequenceGeneratorStartWithOption) sequenceGeneratorOption() SequenceGeneratorOption { return o }
 func (o SequenceGeneratorStartWithOption) Accept(v Visitor) Visitor {
-	return v.Visit(kStartWith, Int(int(o)))
+	return v.Visit(kStartWith, WS, Int(int(o)))
 }
 func (o SequenceGeneratorStartWithOption) String() string { return XQL(o) }
 
@@ -46,7 +46,7 @@ func (o SequenceGeneratorIncrementByOption) sequenceGeneratorOption() SequenceGe
 	return o
 }
 func (o SequenceGeneratorIncrementByOption) Accept(v Visitor) Visitor {
-	return v.Visit(kIncrementBy, Int(int(o)))
+	return v.Visit(kIncrementBy, WS, Int(int(o)))
 }
 func (o SequenceGeneratorIncrementByOption) String() string { return XQL(o) }
 
@@ -59,14 +59,15 @@ func MaxValue(value int) SequenceGeneratorMaxValueOption {
 var NoMaxValue = &SequenceGeneratorMaxValueOption{nil}
 
 const (
-	kNoMaxValue = Keyword("NO MAXVALUE")
-	kMaxValue   = Keyword("MAXVALUE")
+	kNoMaxValue       = Keyword("NO MAXVALUE")
+	kNoMaxValueOracle = Keyword("NOMAXVALUE")
+	kMaxValue         = Keyword("MAXVALUE")
 )
 
 func (o SequenceGeneratorMaxValueOption) sequenceGeneratorOption() SequenceGeneratorOption { return o }
 func (o SequenceGeneratorMaxValueOption) Accept(v Visitor) Visitor {
 	if o.Value == nil {
-		return v.Visit(kNoMaxValue)
+		return v.IfElse(v.Dialect() == Oracle, kNoMaxValueOracle, kNoMaxValue)
 	}
 
 	return v.Visit(kMaxValue, WS, Int(*o.Value))
@@ -83,14 +84,15 @@ func MinValue(value int) SequenceGeneratorMinValueOption {
 var NoMinValue = &SequenceGeneratorMinValueOption{nil}
 
 const (
-	kNoMinValue = Keyword("NO MINVALUE")
-	kMinValue   = Keyword("MINVALUE")
+	kNoMinValue       = Keyword("NO MINVALUE")
+	kNoMinValueOracle = Keyword("NOMINVALUE")
+	kMinValue         = Keyword("MINVALUE")
 )
 
-func (o *SequenceGeneratorMinValueOption) sequenceGeneratorOption() SequenceGeneratorOption { return o }
+func (o SequenceGeneratorMinValueOption) sequenceGeneratorOption() SequenceGeneratorOption { return o }
 func (o SequenceGeneratorMinValueOption) Accept(v Visitor) Visitor {
 	if o.Value == nil {
-		return v.Visit(kNoMinValue)
+		return v.IfElse(v.Dialect() == Oracle, kNoMinValueOracle, kNoMinValue)
 	}
 
 	return v.Visit(kMinValue, WS, Int(*o.Value))
@@ -106,8 +108,9 @@ var (
 )
 
 const (
-	kCycle   = Keyword("CYCLE")
-	kNoCycle = Keyword("NO CYCLE")
+	kCycle         = Keyword("CYCLE")
+	kNoCycle       = Keyword("NO CYCLE")
+	kNoCycleOracle = Keyword("NOCYCLE")
 )
 
 func (o SequenceGeneratorCycleOption) sequenceGeneratorOption() SequenceGeneratorOption { return o }
@@ -117,7 +120,146 @@ func (o SequenceGeneratorCycleOption) Accept(v Visitor) Visitor {
 		return v.Visit(kCycle)
 	}
 
-	return v.Visit(kNoCycle)
+	return v.IfElse(v.Dialect() == Oracle, kNoCycleOracle, kNoCycle)
 }
 
 func (o SequenceGeneratorCycleOption) String() string { return XQL(o) }
+
+type SequenceGeneratorRestartOption struct{ Value *int }
+
+// Restart restarts the sequence at its start value.
+var Restart = &SequenceGeneratorRestartOption{nil}
+
+// RestartWith restarts the sequence at the value.
+func RestartWith(value int) *SequenceGeneratorRestartOption {
+	return &SequenceGeneratorRestartOption{&value}
+}
+
+const kRestart = Keyword("RESTART")
+
+func (o *SequenceGeneratorRestartOption) sequenceGeneratorOption() SequenceGeneratorOption { return o }
+func (o *SequenceGeneratorRestartOption) Accept(v Visitor) Visitor {
+	if o.Value == nil {
+		return v.Visit(kRestart)
+	}
+
+	return v.Visit(kRestart, WS).IfElse(v.Dialect() == Oracle, kStartWith, kWith).Visit(WS, Int(*o.Value))
+}
+
+func (o *SequenceGeneratorRestartOption) String() string { return XQL(o) }
+
+type SequenceName = SchemaQualifiedName
+
+// SequenceDef define an external sequence generator.
+//
+//	<sequence generator definition> ::=
+//		CREATE SEQUENCE <sequence generator name> [ <sequence generator options> ]
+//
+// MySQL and SQLite have no sequences, Oracle spells the NO options as one word and has no AS clause.
+//
+// https://jakewheat.github.io/sql-overview/sql-2016-foundation-grammar.html#sequence-generator-definition
+type SequenceDef struct {
+	Name    *SequenceName
+	Type    DataType
+	Options []SequenceGeneratorOption
+}
+
+// CreateSequence creates a sequence generator.
+func CreateSequence[T ToSchemaQualifiedName](name T, x ...SequenceGeneratorOption) *SequenceDef {
+	return &SequenceDef{Name: SchemaQName(name), Options: x}
+}
+
+// As sets the data type of the sequence values.
+func (d *SequenceDef) As(t ToDataType) *SequenceDef {
+	d.Type = t.dataType()
+	return d
+}
+
+const kCreateSequence = Keyword("CREATE SEQUENCE")
+
+func (d *SequenceDef) Accept(v Visitor) Visitor {
+	only(v, kCreateSequence.String(), PostgreSQL, SQLServer, Oracle)
+
+	if d.Type != nil {
+		only(v, "CREATE SEQUENCE AS", PostgreSQL, SQLServer)
+	}
+
+	return v.Visit(kCreateSequence, WS, d.Name).
+		IfNotNil(d.Type, WS, kAs, AcceptFunc(func(v Visitor) Visitor { return v.WS().DataType(d.Type) })).
+		IfNotNil(d.Options, WS, Joins(d.Options, WS))
+}
+
+func (d *SequenceDef) String() string { return XQL(d) }
+
+// AlterSequenceStmt changes the definition of an external sequence generator.
+//
+//	<alter sequence generator statement> ::=
+//		ALTER SEQUENCE <sequence generator name> <alter sequence generator options>
+type AlterSequenceStmt struct {
+	Name    *SequenceName
+	Options []SequenceGeneratorOption
+}
+
+// AlterSequence changes the options of a sequence generator.
+func AlterSequence[T ToSchemaQualifiedName](name T, x ...SequenceGeneratorOption) *AlterSequenceStmt {
+	return &AlterSequenceStmt{SchemaQName(name), x}
+}
+
+// Restart restarts the sequence at its start value.
+func (s *AlterSequenceStmt) Restart() *AlterSequenceStmt {
+	s.Options = append(s.Options, Restart)
+	return s
+}
+
+// RestartWith restarts the sequence at the value.
+func (s *AlterSequenceStmt) RestartWith(value int) *AlterSequenceStmt {
+	s.Options = append(s.Options, RestartWith(value))
+	return s
+}
+
+const kAlterSequence = Keyword("ALTER SEQUENCE")
+
+func (s *AlterSequenceStmt) Accept(v Visitor) Visitor {
+	only(v, kAlterSequence.String(), PostgreSQL, SQLServer, Oracle)
+
+	return v.Visit(kAlterSequence, WS, s.Name).IfNotNil(s.Options, WS, Joins(s.Options, WS))
+}
+
+func (s *AlterSequenceStmt) String() string { return XQL(s) }
+
+// NextValueExpr generates the next value of a sequence generator.
+//
+//	<next value expression> ::= NEXT VALUE FOR <sequence generator name>
+//
+// It is rendered as nextval('seq') on PostgreSQL and as seq.NEXTVAL on Oracle,
+// MySQL and SQLite have no sequences.
+type NextValueExpr struct {
+	Sequence *SequenceName
+}
+
+// NextValueFor generates the next value of the sequence.
+func NextValueFor[T ToSchemaQualifiedName](name T) *NextValueExpr {
+	return &NextValueExpr{SchemaQName(name)}
+}
+
+const (
+	kNextValueFor  = Keyword("NEXT VALUE FOR")
+	kNextVal       = Keyword("nextval")
+	kNextValSuffix = Keyword("NEXTVAL")
+)
+
+func (e *NextValueExpr) expr() Expr                { return e }
+func (e *NextValueExpr) AsDefault() *DefaultClause { return &DefaultClause{e} }
+func (e *NextValueExpr) Accept(v Visitor) Visitor {
+	switch v.Dialect() {
+	case PostgreSQL:
+		return v.Visit(kNextVal, Paren(Raw(quoteLiteral(e.Sequence.String()))))
+	case Oracle:
+		return v.Visit(e.Sequence, Token('.'), kNextValSuffix)
+	case MySQL, SQLite:
+		return v.Fail(fmt.Errorf("%w: %s", ErrUnsupported, kNextValueFor))
+	default:
+		return v.Visit(kNextValueFor, WS, e.Sequence)
+	}
+}
+func (e *NextValueExpr) String() string { return XQL(e) }
@@ -0,0 +1,67 @@
+package xql_test
+
+import (
+	"fmt"
+
+	. "github.com/flier/xql"
+)
+
+func ExampleCreateSequence() {
+	fmt.Println(CreateSequence("serial"))
+	fmt.Println(CreateSequence("serial", StartWith(101), IncrementBy(2), MaxValue(1000), Cycle).As(BigInt))
+	fmt.Println(AlterSequence("serial").RestartWith(105))
+	fmt.Println(AlterSequence("serial", IncrementBy(5), NoMinValue).Restart())
+	fmt.Println(DropSequence("serial"))
+	fmt.Println(DropSequence("serial").Cascade())
+	fmt.Println(Oracle.XQL(CreateSequence("serial", NoMinValue, NoMaxValue, NoCycle)))
+	fmt.Println(Oracle.XQL(AlterSequence("serial").RestartWith(105)))
+
+	_, err := Oracle.Build(CreateSequence("serial").As(BigInt))
+	fmt.Println(err)
+	_, err = MySQL.Build(CreateSequence("serial"))
+	fmt.Println(err)
+	// Output:
+	// CREATE SEQUENCE serial
+	// CREATE SEQUENCE serial AS BIGINT START WITH 101 INCREMENT BY 2 MAXVALUE 1000 CYCLE
+	// ALTER SEQUENCE serial RESTART WITH 105
+	// ALTER SEQUENCE serial INCREMENT BY 5 NO MINVALUE RESTART
+	// DROP SEQUENCE serial
+	// DROP SEQUENCE serial CASCADE
+	// CREATE SEQUENCE serial NOMINVALUE NOMAXVALUE NOCYCLE
+	// ALTER SEQUENCE serial RESTART START WITH 105
+	// xql: unsupported by the dialect: CREATE SEQUENCE AS
+	// xql: unsupported by the dialect: CREATE SEQUENCE
+}
+
+func ExampleNextValueFor() {
+	seq := NextValueFor("serial")
+	insert := InsertInto("distributors", Columns("did", "name").Values(seq, "XYZ Widgets"))
+	update := Update("distributors").Set(Assign("did", seq)).Where(Raw("did = 0"))
+	create := CreateTable("distributors", Column("did", Integer, seq.AsDefault()))
+
+	for _, d := range []Dialect{StandardSQL, PostgreSQL, Oracle} {
+		fmt.Println(d.XQL(insert))
+		fmt.Println(d.XQL(update))
+		fmt.Println(d.XQL(create))
+	}
+
+	_, err := SQLite.Build(insert)
+	fmt.Println(err)
+	// Output:
+	// INSERT INTO distributors (did, name) VALUES (NEXT VALUE FOR serial, 'XYZ Widgets')
+	// UPDATE distributors SET did = NEXT VALUE FOR serial WHERE did = 0
+	// CREATE TABLE distributors (
+	// 	did INTEGER DEFAULT NEXT VALUE FOR serial
+	// )
+	// INSERT INTO distributors (did, name) VALUES (nextval('serial'), 'XYZ Widgets')
+	// UPDATE distributors SET did = nextval('serial') WHERE did = 0
+	// CREATE TABLE distributors (
+	// 	did INTEGER DEFAULT nextval('serial')
+	// )
+	// INSERT INTO distributors (did, name) VALUES (serial.NEXTVAL, 'XYZ Widgets')
+	// UPDATE distributors SET did = serial.NEXTVAL WHERE did = 0
+	// CREATE TABLE distributors (
+	// 	did INTEGER DEFAULT serial.NEXTVAL
+	// )
+	// xql: unsupported by the dialect: NEXT VALUE FOR
+}
@@ -27,7 +27,8 @@ func Assign[T AsSetTarget](target T, source any) Assignment {
 
 type SetClauseList []SetClause
 
-func (l SetClauseList) String() string { return Join(l, ", ") }
+func (l SetClauseList) Accept(v Visitor) Visitor { return v.Visit(Joins(accepts(l), Sep)) }
+func (l SetClauseList) String() string           { return XQL(l) }
 
 type ToSetClause interface {
 	setClause() SetClause
@@ -62,7 +63,10 @@ func newColumnAssignment[T ~string | *MutatedSetClause](target T, source UpdateS
 }
 
 func (c *ColumnAssignment) setClause() SetClause { return c }
-func (c *ColumnAssignment) String() string       { return fmt.Sprintf("%s = %s", c.Target, c.Source) }
+func (c *ColumnAssignment) Accept(v Visitor) Visitor {
+	return v.Visit(accept(c.Target), WS, CompEq, WS, accept(c.Source))
+}
+func (c *ColumnAssignment) String() string { return XQL(c) }
 
 type AssignedRow = TypedRowValueExpr
 
@@ -77,10 +81,12 @@ func newMultiColumnAssignment(target []SetTarget, source AssignedRow) *MultiColu
 
 func (c *MultiColumnAssignment) setClause() SetClause { return c }
 
-func (c *MultiColumnAssignment) String() string {
-	return fmt.Sprintf("(%s) = %s", Join(c.Targets, ", "), c.Source)
+func (c *MultiColumnAssignment) Accept(v Visitor) Visitor {
+	return v.Visit(Paren(Joins(accepts(c.Targets), Sep)), WS, CompEq, WS, accept(c.Source))
 }
 
+func (c *MultiColumnAssignment) String() string { return XQL(c) }
+
 type ToSetTarget interface {
 	setTarget() SetTarget
 }
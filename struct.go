@@ -0,0 +1,382 @@
+package xql
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// structField is a struct field mapped to a column by its `xql:"name,pk,omitempty,readonly,default,version"` tag.
+//
+// The column is named after the field in snake case when the tag has no name,
+// and the field is skipped when the name is "-".
+type structField struct {
+	Name      ColumnName
+	Index     []int
+	PK        bool // the field is a part of the primary key
+	OmitEmpty bool // the column is omitted when the field is a zero value
+	ReadOnly  bool // the column is never inserted or updated
+	Default   bool // the column is inserted as DEFAULT when the field is a zero value
+	Version   bool // the column is the version of the row for optimistic locking
+}
+
+type structInfo struct {
+	Fields []*structField
+}
+
+var structInfos sync.Map // map[reflect.Type]*structInfo
+
+// structInfoOf returns the fields of the struct type, they are only reflected once.
+func structInfoOf(t reflect.Type) *structInfo {
+	if info, ok := structInfos.Load(t); ok {
+		return info.(*structInfo)
+	}
+
+	info := &structInfo{structFields(t, nil)}
+	actual, _ := structInfos.LoadOrStore(t, info)
+
+	return actual.(*structInfo)
+}
+
+func structFields(t reflect.Type, index []int) (fields []*structField) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, hasTag := f.Tag.Lookup("xql")
+		opts := strings.Split(tag, ",")
+		idx := append(append([]int(nil), index...), i)
+
+		if opts[0] == "-" {
+			continue
+		}
+
+		ft := f.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+
+		if f.Anonymous && ft.Kind() == reflect.Struct && (!hasTag || opts[0] == "") {
+			fields = append(fields, structFields(ft, idx)...)
+			continue
+		}
+
+		if !f.IsExported() {
+			continue
+		}
+
+		field := &structField{Name: opts[0], Index: idx}
+		if field.Name == "" {
+			field.Name = snakeCase(f.Name)
+		}
+
+		for _, opt := range opts[1:] {
+			switch opt {
+			case "pk":
+				field.PK = true
+			case "omitempty":
+				field.OmitEmpty = true
+			case "readonly":
+				field.ReadOnly = true
+			case "default":
+				field.Default = true
+			case "version":
+				field.Version = true
+			}
+		}
+
+		fields = append(fields, field)
+	}
+
+	return
+}
+
+// snakeCase converts a field name such as UserID to user_id.
+func snakeCase(s string) string {
+	var b strings.Builder
+
+	r := []rune(s)
+	for i, c := range r {
+		if unicode.IsUpper(c) {
+			if i > 0 && (!unicode.IsUpper(r[i-1]) || i+1 < len(r) && unicode.IsLower(r[i+1])) {
+				b.WriteByte('_')
+			}
+			c = unicode.ToLower(c)
+		}
+		b.WriteRune(c)
+	}
+
+	return b.String()
+}
+
+// value returns the field of the struct, or nil when it is behind a nil embedded pointer.
+func (f *structField) value(v reflect.Value) (reflect.Value, bool) {
+	fv, err := v.FieldByIndexErr(f.Index)
+	if err != nil {
+		return reflect.Value{}, false
+	}
+
+	return fv, true
+}
+
+// isZero reports whether the field is a zero value.
+func (f *structField) isZero(v reflect.Value) bool {
+	fv, ok := f.value(v)
+	return !ok || fv.IsZero()
+}
+
+// expr converts the field into a value, or DEFAULT when it is a zero default field.
+func (f *structField) expr(v reflect.Value) TypedRowValueExpr {
+	fv, ok := f.value(v)
+	if !ok {
+		return Nil
+	}
+
+	if f.Default && fv.IsZero() {
+		return Default
+	}
+
+	return f.literal(fv)
+}
+
+// key converts the field into a value which identifies the row, it is never DEFAULT.
+func (f *structField) key(v reflect.Value) TypedRowValueExpr {
+	fv, ok := f.value(v)
+	if !ok {
+		return Nil
+	}
+
+	return f.literal(fv)
+}
+
+// literal converts the value of the field, driver.Valuer is used for the custom types.
+func (f *structField) literal(fv reflect.Value) TypedRowValueExpr {
+	if valuer, ok := fv.Interface().(driver.Valuer); ok {
+		if fv.Kind() == reflect.Ptr && fv.IsNil() {
+			return Nil
+		}
+
+		x, err := valuer.Value()
+		if err != nil {
+			return &errValue{fmt.Errorf("xql: column %s: %w", f.Name, err)}
+		}
+
+		return newTypedRowValueExpr(x)
+	}
+
+	for fv.Kind() == reflect.Ptr {
+		if fv.IsNil() {
+			return Nil
+		}
+		fv = fv.Elem()
+	}
+
+	return newTypedRowValueExpr(fv.Interface())
+}
+
+// errValue fails the building of the statement with the error of a value conversion.
+type errValue struct {
+	err error
+}
+
+func (v *errValue) expr() Expr                   { return v }
+func (v *errValue) boolValueExpr() BoolValueExpr { return v }
+func (v *errValue) Accept(x Visitor) Visitor     { return x.Fail(v.err) }
+func (v *errValue) String() string               { return XQL(v) }
+
+// structOf returns the struct pointed by x, it fails when x isn't a struct.
+func structOf(x any) (reflect.Value, error) {
+	v := reflect.ValueOf(x)
+	for v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return v, fmt.Errorf("xql: %T is not a struct", x)
+	}
+
+	return v, nil
+}
+
+// InsertStruct inserts the struct into the table,
+// the readonly fields and the empty omitempty fields are not inserted.
+func InsertStruct[T ToLocalOrSchemaQualifiedName](name T, x any) *InsertStmt {
+	v, err := structOf(x)
+	if err != nil {
+		return InsertInto(name, Values(&errValue{err}))
+	}
+
+	var columns []ColumnName
+	var values []any
+
+	for _, f := range structInfoOf(v.Type()).Fields {
+		if f.ReadOnly || f.OmitEmpty && f.isZero(v) {
+			continue
+		}
+
+		columns = append(columns, f.Name)
+		values = append(values, f.expr(v))
+	}
+
+	return InsertInto(name, Columns(columns...).Values(values...))
+}
+
+// InsertStructs inserts the slice of structs into the table,
+// the empty omitempty fields are inserted as DEFAULT because every row has the same columns.
+//
+// The statement fails with ErrNoChanges when there is no struct to insert.
+func InsertStructs[T ToLocalOrSchemaQualifiedName](name T, x any) *InsertStmt {
+	s := reflect.ValueOf(x)
+	if s.Kind() != reflect.Slice && s.Kind() != reflect.Array {
+		return InsertInto(name, Values(&errValue{fmt.Errorf("xql: %T is not a slice", x)}))
+	}
+
+	if s.Len() == 0 {
+		return InsertInto(name, Values(&errValue{fmt.Errorf("%w to insert", ErrNoChanges)}))
+	}
+
+	t := s.Type().Elem()
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct {
+		return InsertInto(name, Values(&errValue{fmt.Errorf("xql: %T is not a slice of structs", x)}))
+	}
+
+	fields := structInfoOf(t).Fields
+
+	var columns []ColumnName
+	for _, f := range fields {
+		if !f.ReadOnly {
+			columns = append(columns, f.Name)
+		}
+	}
+
+	rows := make([]any, s.Len())
+	for i := range rows {
+		v, err := structOf(s.Index(i).Interface())
+		if err != nil {
+			return InsertInto(name, Values(&errValue{err}))
+		}
+
+		var row Row
+		for _, f := range fields {
+			switch {
+			case f.ReadOnly:
+			case f.OmitEmpty && f.isZero(v):
+				row = append(row, Default)
+			default:
+				row = append(row, f.expr(v))
+			}
+		}
+
+		rows[i] = row
+	}
+
+	return InsertInto(name, Columns(columns...).Values(rows...))
+}
+
+// StructOption configures how a statement is built from a struct.
+type StructOption interface {
+	applyStructOptions(*structOptions)
+}
+
+type structOptions struct {
+	by      []ColumnName
+	version ColumnName
+}
+
+// key reports whether the field identifies the row.
+func (o *structOptions) key(f *structField) bool {
+	if o.by == nil {
+		return f.PK
+	}
+
+	return o.byColumn(f.Name)
+}
+
+// byColumn reports whether the column is one of the By columns.
+func (o *structOptions) byColumn(name ColumnName) bool {
+	for _, by := range o.by {
+		if by == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// where identifies the row by the key conditions, it fails when the row can't be identified.
+func (o *structOptions) where(s *UpdateStmt, conds []SearchCond, lock ...SearchCond) (*UpdateStmt, error) {
+	if len(conds) == 0 || o.by != nil && len(conds) < len(o.by) {
+		return nil, fmt.Errorf("%w to identify the updated row", ErrNoKey)
+	}
+
+	conds = append(conds, lock...)
+
+	if len(conds) == 1 {
+		return s.Where(conds[0]), nil
+	}
+
+	return s.Where(And(conds...)), nil
+}
+
+func newStructOptions(opts []StructOption) *structOptions {
+	o := &structOptions{}
+	for _, opt := range opts {
+		opt.applyStructOptions(o)
+	}
+	return o
+}
+
+type applyStructOptionsFunc func(*structOptions)
+
+func (f applyStructOptionsFunc) applyStructOptions(o *structOptions) { f(o) }
+
+// By identifies the updated row by the columns instead of the pk fields.
+func By(x ...ColumnName) StructOption {
+	return applyStructOptionsFunc(func(o *structOptions) { o.by = x })
+}
+
+// UpdateStruct updates the row identified by the pk fields, or the By columns, with the struct.
+//
+// The key, pk, readonly and empty omitempty fields are not updated.
+// The statement fails with ErrNoKey when the row can't be identified, rather than updating every row,
+// a zero default key is missing since the database generates it on insertion.
+func UpdateStruct[T ToTargetTable](target T, x any, opts ...StructOption) *UpdateStmt {
+	s := Update(target)
+
+	v, err := structOf(x)
+	if err != nil {
+		return s.Where(&errValue{err})
+	}
+
+	o := newStructOptions(opts)
+
+	var conds []SearchCond
+	var missing bool
+	for _, f := range structInfoOf(v.Type()).Fields {
+		switch {
+		case o.key(f) && f.Default && f.isZero(v):
+			missing = true
+		case o.key(f):
+			conds = append(conds, Eq(QName(f.Name), f.key(v)))
+		case f.PK || f.ReadOnly || f.OmitEmpty && f.isZero(v):
+		default:
+			s.Set(Assign(f.Name, f.expr(v)))
+		}
+	}
+
+	if missing {
+		conds = nil
+	}
+
+	u, err := o.where(s, conds)
+	if err != nil {
+		return s.Where(&errValue{fmt.Errorf("%w of %s", err, v.Type())})
+	}
+
+	return u
+}
@@ -0,0 +1,76 @@
+package xql_test
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+
+	. "github.com/flier/xql"
+)
+
+type Email string
+
+func (e Email) Value() (driver.Value, error) { return strings.ToLower(string(e)), nil }
+
+type Timestamps struct {
+	CreatedAt string `xql:",readonly"`
+	UpdatedAt string `xql:",omitempty"`
+}
+
+type Account struct {
+	ID    int    `xql:"id,pk,default"`
+	Name  string `xql:"name"`
+	Email Email
+	Notes string `xql:"-"`
+	Timestamps
+}
+
+type Event struct {
+	Name       string
+	StartsAt   time.Time
+	FinishedAt *time.Time
+}
+
+func ExampleInsertStruct() {
+	fmt.Println(InsertStruct("users", &Account{Name: "alice", Email: "Alice@Example.com"}))
+	fmt.Println(InsertStructs("users", []Account{
+		{ID: 1, Name: "bob", Email: "bob@example.com"},
+		{ID: 2, Name: "carol", Email: "carol@example.com", Timestamps: Timestamps{UpdatedAt: "2023-01-01"}},
+	}))
+
+	launch := Event{Name: "launch", StartsAt: time.Date(2023, 1, 1, 9, 30, 0, 0, time.UTC)}
+	fmt.Println(InsertStruct("events", launch))
+	fmt.Println(SQLServer.XQL(InsertStruct("events", launch)))
+
+	_, err := Build(InsertStructs("users", []Account{}))
+	fmt.Println(err)
+	_, err = Build(InsertStruct("users", 42))
+	fmt.Println(err)
+	// Output:
+	// INSERT INTO users (id, name, email) VALUES (DEFAULT, 'alice', 'alice@example.com')
+	// INSERT INTO users (id, name, email, updated_at) VALUES
+	// 	ROW(1, 'bob', 'bob@example.com', DEFAULT),
+	// 	ROW(2, 'carol', 'carol@example.com', '2023-01-01')
+	// INSERT INTO events (name, starts_at, finished_at) VALUES ('launch', TIMESTAMP '2023-01-01 09:30:00', NULL)
+	// INSERT INTO events (name, starts_at, finished_at) VALUES ('launch', '2023-01-01 09:30:00', NULL)
+	// xql: no changes to insert
+	// xql: int is not a struct
+}
+
+func ExampleUpdateStruct() {
+	u := Account{ID: 1, Name: "alice", Email: "alice@example.com", Timestamps: Timestamps{UpdatedAt: "2023-01-01"}}
+
+	fmt.Println(UpdateStruct("users", u))
+	fmt.Println(UpdateStruct("users", u, By("email")))
+
+	_, err := Build(UpdateStruct("users", Account{Name: "bob"}))
+	fmt.Println(err)
+	_, err = Build(UpdateStruct("events", Event{Name: "launch"}))
+	fmt.Println(err)
+	// Output:
+	// UPDATE users SET name = 'alice', email = 'alice@example.com', updated_at = '2023-01-01' WHERE id = 1
+	// UPDATE users SET name = 'alice', updated_at = '2023-01-01' WHERE email = 'alice@example.com'
+	// xql: no key to identify the updated row of xql_test.Account
+	// xql: no key to identify the updated row of xql_test.Event
+}
@@ -20,12 +20,13 @@ func newTableName[T ToTableName](name T) *TableName {
 func (n *TableName) tablePrimary() TablePrimary { return n }
 func (n *TableName) targetTable() TargetTable   { return n }
 func (n *TableName) tableRef() TableRef         { return n }
-func (n *TableName) Accept(v Visitor) Visitor   { return v.Ident(n) }
+func (n *TableName) Accept(v Visitor) Visitor   { return v.Visit(qualifiedIdent(n)) }
 func (n *TableName) String() string             { return ((*LocalOrSchemaQualifiedName)(n)).String() }
 
 type TableRefList []TableRef
 
-func (l TableRefList) String() string { return Join(l, ", ") }
+func (l TableRefList) Accept(v Visitor) Visitor { return v.Visit(Joins(accepts(l), Sep)) }
+func (l TableRefList) String() string           { return XQL(l) }
 
 type ToTableRef interface {
 	tableRef() TableRef
@@ -52,18 +53,12 @@ type TableFactor struct {
 
 func (f *TableFactor) tableRef() TableRef { return f }
 
-func (f *TableFactor) String() string {
-	var b strings.Builder
-
-	fmt.Fprintf(&b, "%s", f.Primary)
-
-	if f.Sample == nil {
-		fmt.Fprintf(&b, " %s", f.Sample)
-	}
-
-	return b.String()
+func (f *TableFactor) Accept(v Visitor) Visitor {
+	return v.Visit(accept(f.Primary)).IfNotNil(f.Sample, WS, accept(f.Sample))
 }
 
+func (f *TableFactor) String() string { return XQL(f) }
+
 type TablePrimary interface {
 	fmt.Stringer
 
@@ -72,6 +67,7 @@ type TablePrimary interface {
 
 var (
 	_ TablePrimary = &DataSource{}
+	_ TablePrimary = &DerivedTable{}
 	_ TablePrimary = QueryName("")
 )
 
@@ -96,40 +92,48 @@ func (n *SchemaQualifiedName) As(alias CorrelationName) *DataSource {
 
 func (s *DataSource) tableRef() TableRef         { return &TableFactor{Primary: s} }
 func (s *DataSource) tablePrimary() TablePrimary { return s }
-func (s *DataSource) String() string {
-	var b strings.Builder
+func (s *DataSource) Accept(v Visitor) Visitor {
+	return v.Visit(s.Table).IfNotNil(s.Correlation, WS, s.Correlation)
+}
 
-	b.WriteString(s.Table.String())
+func (s *DataSource) String() string { return XQL(s) }
 
-	if s.Correlation != nil {
-		fmt.Fprintf(&b, " %s", s.Correlation)
-	}
+// DerivedTable is a parenthesized query used as a table.
+//
+//	<derived table> ::= <table subquery>
+type DerivedTable struct {
+	Query       QueryExprBody
+	Correlation *CorrelationClause
+}
 
-	return b.String()
+func (t *DerivedTable) tableRef() TableRef         { return &TableFactor{Primary: t} }
+func (t *DerivedTable) tablePrimary() TablePrimary { return t }
+func (t *DerivedTable) Accept(v Visitor) Visitor {
+	return v.Visit(Paren(accept(t.Query))).IfNotNil(t.Correlation, WS, t.Correlation)
 }
 
+func (t *DerivedTable) String() string { return XQL(t) }
+
 type CorrelationClause struct {
 	Name    CorrelationName
 	Columns ColumnNameList
 }
 
-func (c *CorrelationClause) String() string {
-	var b strings.Builder
-
-	fmt.Fprintf(&b, "AS %s", c.Name)
-
-	if len(c.Columns) > 0 {
-		fmt.Fprintf(&b, " %s", c.Columns)
-	}
-
-	return b.String()
+func (c *CorrelationClause) Accept(v Visitor) Visitor {
+	return v.IfElse(v.Dialect() == Oracle, Ident(Raw(c.Name)), AcceptFunc(func(v Visitor) Visitor {
+		return v.Visit(kAs, WS).Ident(Raw(c.Name))
+	})).IfNotNil(c.Columns, WS, c.Columns)
 }
 
+func (c *CorrelationClause) String() string { return XQL(c) }
+
 type TableDef struct {
 	Scope            *TableScope
 	Name             *TableName
 	Content          TableContentSource
 	SystemVersioning *SystemVersioningClause
+	Supertables      []*TableName
+	Partitioning     *PartitionSpec
 	OnCommit         *TableCommitAction
 }
 
@@ -179,32 +183,78 @@ func (t *TableDef) Of(typeName string, x ...TypedTableDefOption) *TableDef {
 	return t
 }
 
-func (t *TableDef) String() string {
-	var b strings.Builder
+// Under makes the table a subtable of the supertables.
+//
+// A typed table is rendered UNDER its single supertable, it fails to build with more.
+// PostgreSQL has no typed table hierarchies, its other tables inherit the columns of one or more parent tables
+// with INHERITS instead. Which one applies is decided when the statement is built, whatever the order of Of and Under.
+func (t *TableDef) Under(super ...string) *TableDef {
+	for _, name := range super {
+		t.Supertables = append(t.Supertables, newTableName(name))
+	}
 
-	b.WriteString("CREATE")
+	return t
+}
 
-	if t.Scope != nil {
-		fmt.Fprintf(&b, " %s", t.Scope)
-	}
+const (
+	kCreate   = Keyword("CREATE")
+	kTable    = Keyword("TABLE")
+	kOf       = Keyword("OF")
+	kInherits = Keyword("INHERITS")
+	kOnCommit = Keyword("ON COMMIT")
+)
 
-	fmt.Fprintf(&b, " TABLE %s", t.Name)
+func (t *TableDef) Accept(v Visitor) Visitor {
+	v.Visit(kCreate).
+		IfNotNil(t.Scope, WS, accept(t.Scope)).
+		Visit(WS, kTable, WS, t.Name)
 
-	if t.Content != nil {
-		fmt.Fprintf(&b, " %s", t.Content)
-	}
+	switch c := t.Content.(type) {
+	case *AsSubQueryClause:
+		// The query comes last, after the table options.
+		if c.Columns != nil {
+			only(v, "CREATE TABLE (columns) AS", PostgreSQL, Oracle)
+		}
 
-	if t.SystemVersioning != nil {
-		fmt.Fprintf(&b, " WITH %s", t.SystemVersioning)
+		return v.IfNotNil(c.Columns, WS, c.Columns).
+			IfNotNil(t.OnCommit, WS, kOnCommit, WS, accept(t.OnCommit)).
+			Visit(WS, c)
+	case TableElementList:
+		if v.Dialect() == MySQL && c.like() != nil {
+			if len(c) > 1 {
+				v.Fail(fmt.Errorf("%w: LIKE with other table elements", ErrUnsupported))
+			} else if c.like().Options != nil {
+				v.Fail(fmt.Errorf("%w: LIKE with options", ErrUnsupported))
+			}
+
+			return v.Visit(WS, kLike, WS, c.like().Name)
+		}
 	}
 
-	if t.OnCommit != nil {
-		fmt.Fprintf(&b, " ON COMMIT %s", t.OnCommit)
+	content, inherits := t.Content, t.Supertables
+
+	if c, ok := t.Content.(*TypedTableClause); ok && inherits != nil {
+		if len(inherits) > 1 {
+			v.Fail(fmt.Errorf("%w: CREATE TABLE OF ... UNDER more than one supertable", ErrUnsupported))
+		}
+
+		typed := *c
+		typed.SubTable = &SubTableClause{inherits[0]}
+		content, inherits = &typed, nil
+	} else if inherits != nil && v.Dialect() != PostgreSQL {
+		// The standard only has subtables of typed tables.
+		v.Fail(fmt.Errorf("%w: CREATE TABLE ... INHERITS", ErrUnsupported))
 	}
 
-	return b.String()
+	return v.IfNotNil(content, WS, accept(content)).
+		IfNotNil(inherits, WS, kInherits, WS, Paren(Joins(inherits, Sep))).
+		IfNotNil(t.Partitioning, WS, t.Partitioning).
+		IfNotNil(t.SystemVersioning, WS, kWith, WS, accept(t.SystemVersioning)).
+		IfNotNil(t.OnCommit, WS, kOnCommit, WS, accept(t.OnCommit))
 }
 
+func (t *TableDef) String() string { return XQL(t) }
+
 type TableScope struct {
 	Global    *bool
 	Temporary *bool
@@ -310,6 +360,7 @@ type TableContentSource interface {
 var (
 	_ TableContentSource = TableElementList{}
 	_ TableContentSource = &TypedTableClause{}
+	_ TableContentSource = &PartitionOfClause{}
 	_ TableContentSource = &AsSubQueryClause{}
 )
 
@@ -317,7 +368,21 @@ type TableElementList []TableElement
 
 func (l TableElementList) tableContentSource() TableContentSource { return l }
 func (l TableElementList) applyTableDef(t *TableDef)              { t.Content = l }
-func (l TableElementList) String() string                         { return fmt.Sprintf("(\n\t%s\n)", Join(l, ",\n\t")) }
+func (l TableElementList) Accept(v Visitor) Visitor {
+	return v.Visit(Raw("(\n\t"), Joins(accepts(l), Raw(",\n\t")), Raw("\n)"))
+}
+func (l TableElementList) String() string { return XQL(l) }
+
+// like returns the first LIKE clause of the elements.
+func (l TableElementList) like() *LikeClause {
+	for _, e := range l {
+		if c, ok := e.(*LikeClause); ok {
+			return c
+		}
+	}
+
+	return nil
+}
 
 type ToTableElement interface {
 	tableElement() TableElement
@@ -400,22 +465,31 @@ func (d *TableConstraintDef) applyTableDef(t *TableDef) {
 	t.Content = TableElementList(append(l, d))
 }
 
-func (d *TableConstraintDef) String() string {
-	var b strings.Builder
+func (d *TableConstraintDef) tableConstraintDef() *TableConstraintDef { return d }
+func (d *TableConstraintDef) Accept(v Visitor) Visitor {
+	acceptEnforcement(v, d.Constraint, d.Characteristics)
 
-	if d.Name != nil {
-		fmt.Fprintf(&b, "%s ", d.Name)
-	}
-
-	b.WriteString(d.Constraint.String())
+	return v.IfNotNil(d.Name, d.Name, WS).
+		Visit(accept(d.Constraint)).
+		IfNotNil(d.Characteristics, WS, d.Characteristics)
+}
 
-	if d.Characteristics != nil {
-		fmt.Fprintf(&b, " %s", d.Characteristics)
-	}
+func (d *TableConstraintDef) String() string { return XQL(d) }
 
-	return b.String()
+// ToTableConstraintDef converts a constraint into a table constraint definition.
+type ToTableConstraintDef interface {
+	tableConstraintDef() *TableConstraintDef
 }
 
+var (
+	_ ToTableConstraintDef = &TableConstraintDef{}
+	_ ToTableConstraintDef = &GenericConstraintDef{}
+	_ ToTableConstraintDef = &UniqueConstraintDef{}
+	_ ToTableConstraintDef = &ReferentialConstraintDef{}
+	_ ToTableConstraintDef = &CheckConstraintDef{}
+	_ ToTableConstraintDef = &ExclusionConstraintDef{}
+)
+
 type ToTableConstraint interface {
 	tableConstraint() TableConstraint
 }
@@ -432,6 +506,7 @@ var (
 	_ TableConstraint = &UniqueConstraintDef{}
 	_ TableConstraint = &ReferentialConstraintDef{}
 	_ TableConstraint = &CheckConstraintDef{}
+	_ TableConstraint = &ExclusionConstraintDef{}
 )
 
 type TypedTableClause struct {
@@ -442,24 +517,30 @@ type TypedTableClause struct {
 
 func (c *TypedTableClause) tableContentSource() TableContentSource { return c }
 func (c *TypedTableClause) applyTableDef(t *TableDef)              { t.Content = c }
-func (c *TypedTableClause) String() string {
-	var b strings.Builder
+func (c *TypedTableClause) Accept(v Visitor) Visitor {
+	return v.Visit(kOf, WS, &c.Name).
+		IfNotNil(c.SubTable, WS, c.SubTable).
+		If(len(c.Elements) > 0, WS, Raw("(\n\t"), Joins(accepts(c.Elements), Raw(",\n\t")), Raw("\n)"))
+}
 
-	fmt.Fprintf(&b, "OF %s", &c.Name)
-	if c.SubTable != nil {
-		fmt.Fprintf(&b, " %s", c.SubTable)
-	}
-	if len(c.Elements) > 0 {
-		fmt.Fprintf(&b, " (\n\t%s\n)", Join(c.Elements, ",\n\t"))
-	}
+func (c *TypedTableClause) String() string { return XQL(c) }
 
-	return b.String()
+// SubTableClause makes a typed table a subtable of the supertable, whose type is the supertype of its type.
+//
+//	<subtable clause> ::= UNDER <supertable clause>
+//
+// https://jakewheat.github.io/sql-overview/sql-2016-foundation-grammar.html#subtable-clause
+type SubTableClause struct {
+	Supertable *TableName
 }
 
-type SubTableClause struct {
+func (c *SubTableClause) Accept(v Visitor) Visitor {
+	only(v, "CREATE TABLE OF ... UNDER")
+
+	return v.Visit(kUnder, WS, c.Supertable)
 }
 
-func (c *SubTableClause) String() string { return "" }
+func (c *SubTableClause) String() string { return XQL(c) }
 
 type TypedTableElementList []TypedTableElement
 
@@ -530,8 +611,71 @@ func (s *SelfRefColumnSpec) String() string {
 	return b.String()
 }
 
-type AsSubQueryClause struct{}
+// AsSubQueryClause creates a table from the result of a query.
+//
+//	<as subquery clause> ::= [ <left paren> <column name list> <right paren> ] AS <table subquery> <with or without data>
+//
+// https://jakewheat.github.io/sql-overview/sql-2016-foundation-grammar.html#as-subquery-clause
+type AsSubQueryClause struct {
+	Columns ColumnNameList
+	Query   QueryExprBody
+	Data    *bool
+}
+
+// As creates the table with the columns from the result of the query.
+func (t *TableDef) As(q SelectQuery, x ...ColumnName) *CreateTableAsStep {
+	c := &AsSubQueryClause{Columns: x, Query: q.Query()}
+	t.Content = c
+
+	return &CreateTableAsStep{t, c}
+}
+
+// CreateTableAsStep creates a table from the result of a query.
+type CreateTableAsStep struct {
+	*TableDef
+
+	Clause *AsSubQueryClause
+}
+
+// WithData fills the table with the result of the query.
+func (s *CreateTableAsStep) WithData() *TableDef {
+	data := true
+	s.Clause.Data = &data
+	return s.TableDef
+}
+
+// WithNoData only copies the columns, the table is empty.
+func (s *CreateTableAsStep) WithNoData() *TableDef {
+	data := false
+	s.Clause.Data = &data
+	return s.TableDef
+}
 
 func (c *AsSubQueryClause) tableContentSource() TableContentSource { return c }
 func (c *AsSubQueryClause) applyTableDef(t *TableDef)              { t.Content = c }
-func (c *AsSubQueryClause) String() string                         { return "AS" }
+
+// Accept renders the query and the data option, the columns are rendered with the table definition.
+func (c *AsSubQueryClause) Accept(v Visitor) Visitor {
+	switch v.Dialect() {
+	case SQLServer:
+		return v.Fail(fmt.Errorf("%w: CREATE TABLE AS", ErrUnsupported))
+	case StandardSQL:
+		v.Visit(kAs, WS, Paren(accept(c.Query)))
+	default:
+		v.Visit(kAs, WS, accept(c.Query))
+	}
+
+	if c.Data == nil {
+		return v
+	}
+
+	if !*c.Data {
+		only(v, "CREATE TABLE AS ... WITH NO DATA", PostgreSQL)
+
+		return v.Visit(WS, kWithNoData)
+	}
+
+	return v.If(v.Dialect().Is(StandardSQL, PostgreSQL), WS, kWithData)
+}
+
+func (c *AsSubQueryClause) String() string { return XQL(c) }
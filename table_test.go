@@ -77,9 +77,64 @@ func ExampleCreateTable_of() {
 		PrimaryKey("name"),
 		Column("salary").WithOptions(Literal("1000").AsDefault()),
 	))
+
+	_, err := MySQL.Build(CreateTable("t").Of("ty", ForeignKey("a", "b").References("p", "x")))
+	fmt.Println(err)
 	// Output:
 	// CREATE TABLE employees OF employee_type (
 	// 	PRIMARY KEY (name),
 	// 	salary WITH OPTIONS DEFAULT 1000
 	// )
+	// xql: column count mismatch: 2 referencing columns, 1 referenced
+}
+
+func ExampleTableDef_Under() {
+	fmt.Println(CreateType("person_t").As(Column("name", VarChar(30))).NotFinal())
+	fmt.Println(CreateType("employee_t").Under("person_t").As(Column("salary", Numeric)).NotFinal())
+	fmt.Println(CreateTable("people").Of("person_t", PrimaryKey("name")))
+	fmt.Println(CreateTable("employees").Of("employee_t").Under("people"))
+	fmt.Println(CreateTable("employees").Under("people").Of("employee_t"))
+	fmt.Println(PostgreSQL.XQL(CreateTable("employees", Column("salary", Numeric)).Under("people")))
+
+	_, err := PostgreSQL.Build(CreateTable("employees").Of("employee_t").Under("people"))
+	fmt.Println(err)
+	_, err = StandardSQL.Build(CreateTable("employees", Column("salary", Numeric)).Under("people"))
+	fmt.Println(err)
+	_, err = StandardSQL.Build(CreateTable("managers").Of("manager_t").Under("employees", "people"))
+	fmt.Println(err)
+	// Output:
+	// CREATE TYPE person_t AS (name VARCHAR(30)) NOT FINAL
+	// CREATE TYPE employee_t UNDER person_t AS (salary NUMERIC) NOT FINAL
+	// CREATE TABLE people OF person_t (
+	// 	PRIMARY KEY (name)
+	// )
+	// CREATE TABLE employees OF employee_t UNDER people
+	// CREATE TABLE employees OF employee_t UNDER people
+	// CREATE TABLE employees (
+	// 	salary NUMERIC
+	// ) INHERITS (people)
+	// xql: unsupported by the dialect: CREATE TABLE OF ... UNDER
+	// xql: unsupported by the dialect: CREATE TABLE ... INHERITS
+	// xql: unsupported by the dialect: CREATE TABLE OF ... UNDER more than one supertable
+}
+
+func ExampleTableDef_As() {
+	q := Select(Asterisk).From(QName("films")).Where(Eq(Column("kind"), Raw("'Comedy'")))
+
+	fmt.Println(CreateTable("films_recent").As(q).WithData())
+	fmt.Println(PostgreSQL.XQL(CreateTempTable("films_recent").As(q, "code", "title").OnCommitDrop()))
+	fmt.Println(PostgreSQL.XQL(CreateTable("films_empty").As(q).WithNoData()))
+	fmt.Println(MySQL.XQL(CreateTable("films_recent").As(q).WithData()))
+
+	for _, d := range []Dialect{MySQL, SQLServer} {
+		_, err := d.Build(CreateTable("films_empty").As(q).WithNoData())
+		fmt.Printf("%s: %v\n", d, err)
+	}
+	// Output:
+	// CREATE TABLE films_recent AS (SELECT * FROM films WHERE kind = 'Comedy') WITH DATA
+	// CREATE TEMPORARY TABLE films_recent (code, title) ON COMMIT DROP AS SELECT * FROM films WHERE kind = 'Comedy'
+	// CREATE TABLE films_empty AS SELECT * FROM films WHERE kind = 'Comedy' WITH NO DATA
+	// CREATE TABLE films_recent AS SELECT * FROM films WHERE kind = 'Comedy'
+	// MySQL: xql: unsupported by the dialect: CREATE TABLE AS ... WITH NO DATA
+	// SQL Server: xql: unsupported by the dialect: CREATE TABLE AS
 }
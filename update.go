@@ -1,18 +1,19 @@
 package xql
 
-import (
-	"fmt"
-	"strings"
-)
+import "fmt"
 
 type CorrelationName = string
 
 type UpdateStmt struct {
-	Target TargetTable
-	Alias  CorrelationName
-	Sets   SetClauseList
-	Cursor *CursorName
-	Search SearchCond
+	Target  TargetTable
+	Alias   CorrelationName
+	Sets    SetClauseList
+	Sources TableRefList
+	Cursor  *CursorName
+	Search  SearchCond
+	Return  *ReturningClause
+	Order   OrderByClause
+	Limits  *LimitClause
 }
 
 type UpdateOption interface {
@@ -41,6 +42,13 @@ func (s *UpdateStmt) Set(x ...SetClause) *UpdateStmt {
 	return s
 }
 
+// From joins the target table with the other tables,
+// it is rendered as the multi-table form on MySQL.
+func (s *UpdateStmt) From(x ...ToTableRef) *UpdateStmt {
+	s.Sources = append(s.Sources, TableRefList(From(x...))...)
+	return s
+}
+
 func (s *UpdateStmt) Where(search SearchCond) *UpdateStmt {
 	s.Search = search
 	return s
@@ -51,22 +59,100 @@ func (s *UpdateStmt) WhereCurrentOf(cursor string) *UpdateStmt {
 	return s
 }
 
-func (s *UpdateStmt) String() string {
-	var b strings.Builder
+// OrderBy updates the rows in order, it is supported on single-table updates by MySQL and SQLite.
+func (s *UpdateStmt) OrderBy(x ...ToSortSpec) *UpdateStmt {
+	s.Order = OrderBy(x...)
+	return s
+}
+
+// Limit updates at most n rows, it is supported on single-table updates by MySQL and SQLite.
+func (s *UpdateStmt) Limit(n int) *UpdateStmt {
+	s.Limits = Limit(intValue(n))
+	return s
+}
 
-	fmt.Fprintf(&b, "UPDATE %s", s.Target)
+// Returning returns the columns of the updated rows.
+func (s *UpdateStmt) Returning(x ...SelectFieldOrAsterisk) *UpdateStmt {
+	s.Return = Returning(x...)
+	return s
+}
 
-	if len(s.Alias) > 0 {
-		fmt.Fprintf(&b, " AS %s", s.Alias)
+// ReturningInto stores the returned columns into the variables, as required by Oracle,
+// the columns are given by Returning.
+func (s *UpdateStmt) ReturningInto(vars ...VarName) *UpdateStmt {
+	if s.Return == nil {
+		s.Return = &ReturningClause{}
 	}
 
-	fmt.Fprintf(&b, " SET %s", s.Sets)
+	s.Return.Into = vars
+	return s
+}
+
+const (
+	kUpdate         = Keyword("UPDATE")
+	kSet            = Keyword("SET")
+	kWhereCurrentOf = Keyword("WHERE CURRENT OF")
+)
+
+func (s *UpdateStmt) Accept(v Visitor) Visitor {
+	d := v.Dialect()
 
-	if s.Cursor != nil {
-		fmt.Fprintf(&b, " WHERE CURRENT OF %s", s.Cursor)
-	} else if s.Search != nil {
-		fmt.Fprintf(&b, " WHERE %s", s.Search)
+	if s.Sources != nil && d == Oracle {
+		v.Fail(fmt.Errorf("%w: UPDATE ... FROM", ErrUnsupported))
 	}
 
-	return b.String()
+	if (s.Order != nil || s.Limits != nil) && !orderedModification(d, s.Sources) {
+		v.Fail(fmt.Errorf("%w: UPDATE ... ORDER BY/LIMIT", ErrUnsupported))
+	}
+
+	target := aliasedTarget(s.Target, s.Alias)
+
+	switch {
+	case d == MySQL:
+		v.Visit(kUpdate, WS, target).IfNotNil(s.Sources, Sep, s.Sources)
+	case d == SQLServer && len(s.Alias) > 0:
+		// SQL Server updates the alias of the target table joined in the FROM clause
+		v.Visit(kUpdate, WS, Raw(s.Alias))
+	default:
+		v.Visit(kUpdate, WS, target)
+	}
+
+	v.Visit(WS, kSet, WS, s.Sets)
+
+	if s.Return != nil && d == SQLServer {
+		v.Visit(WS, s.Return.output(kInserted))
+	}
+
+	switch {
+	case d == MySQL:
+	case d == SQLServer && len(s.Alias) > 0:
+		v.Visit(WS, kFrom, WS, target).IfNotNil(s.Sources, Sep, s.Sources)
+	default:
+		v.IfNotNil(s.Sources, WS, kFrom, WS, s.Sources)
+	}
+
+	return v.IfElse(s.Cursor != nil, AcceptFunc(func(v Visitor) Visitor {
+		return v.Visit(WS, kWhereCurrentOf, WS, s.Cursor)
+	}), AcceptFunc(func(v Visitor) Visitor {
+		return v.IfNotNil(s.Search, WS, kWhere, WS, accept(s.Search))
+	})).
+		If(s.Return != nil && d != SQLServer, WS, s.Return).
+		IfNotNil(s.Order, WS, accept(s.Order)).
+		IfNotNil(s.Limits, WS, accept(s.Limits))
+}
+
+func (s *UpdateStmt) String() string { return XQL(s) }
+
+// aliasedTarget renders the target table with its alias, Oracle doesn't accept AS before a table alias.
+func aliasedTarget(target TargetTable, alias CorrelationName) Accepter {
+	return AcceptFunc(func(v Visitor) Visitor {
+		return v.Visit(accept(target)).If(len(alias) > 0, WS, AcceptFunc(func(v Visitor) Visitor {
+			return v.If(v.Dialect() != Oracle, kAs, WS).Visit(Raw(alias))
+		}))
+	})
+}
+
+// orderedModification reports whether the dialect supports ORDER BY and LIMIT on UPDATE and DELETE.
+func orderedModification(d Dialect, sources TableRefList) bool {
+	return d == SQLite || d == MySQL && sources == nil
 }
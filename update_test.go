@@ -13,3 +13,34 @@ func ExampleUpdate() {
 	// UPDATE products SET price = 10 WHERE price = 5
 	// UPDATE mytable SET a = 5, b = 3, c = 1 WHERE a > 0
 }
+
+func ExampleUpdateStmt_From() {
+	update := Update("employees").As("e").
+		Set(Assign("sales_count", Raw("e.sales_count + 1"))).
+		From(QName("accounts").As("a")).
+		Where(Raw("a.name = 'Acme Corporation' AND a.sales_person = e.id"))
+
+	for _, d := range []Dialect{PostgreSQL, MySQL, SQLServer} {
+		fmt.Println(d.XQL(update))
+	}
+
+	expire := Update("orders").
+		Set(Assign("status", "expired")).
+		Where(Raw("created_at < NOW()")).
+		OrderBy(&SortSpec{Key: Raw("created_at")}).
+		Limit(100)
+
+	fmt.Println(MySQL.XQL(expire))
+
+	_, err := Oracle.Build(update)
+	fmt.Println(err)
+	_, err = Build(expire)
+	fmt.Println(err)
+	// Output:
+	// UPDATE employees AS e SET sales_count = e.sales_count + 1 FROM accounts AS a WHERE a.name = 'Acme Corporation' AND a.sales_person = e.id
+	// UPDATE employees AS e, accounts AS a SET sales_count = e.sales_count + 1 WHERE a.name = 'Acme Corporation' AND a.sales_person = e.id
+	// UPDATE e SET sales_count = e.sales_count + 1 FROM employees AS e, accounts AS a WHERE a.name = 'Acme Corporation' AND a.sales_person = e.id
+	// UPDATE orders SET status = 'expired' WHERE created_at < NOW() ORDER BY created_at LIMIT 100
+	// xql: unsupported by the dialect: UPDATE ... FROM
+	// xql: unsupported by the dialect: UPDATE ... ORDER BY/LIMIT
+}
@@ -0,0 +1,216 @@
+package xql
+
+import "fmt"
+
+// OnConflictClause updates or ignores the inserted rows which conflict with the existing ones.
+//
+// It is rendered as ON CONFLICT on PostgreSQL and SQLite, as ON DUPLICATE KEY UPDATE on MySQL,
+// and the INSERT statement is rewritten into a MERGE statement on SQL Server and Oracle.
+type OnConflictClause struct {
+	Columns    ColumnNameList
+	Constraint *ConstraintName
+	Update     SetClauseList
+	Where      SearchCond
+}
+
+// OnConflict handles the rows which conflict on the unique columns.
+func (i *InsertStmt) OnConflict(x ...ColumnName) *InsertOnConflictStep {
+	i.Upsert = &OnConflictClause{Columns: x}
+	return &InsertOnConflictStep{i}
+}
+
+// OnConflictOnConstraint handles the rows which conflict on the unique constraint.
+func (i *InsertStmt) OnConflictOnConstraint(name string) *InsertOnConflictStep {
+	i.Upsert = &OnConflictClause{Constraint: SchemaQName(name)}
+	return &InsertOnConflictStep{i}
+}
+
+type InsertOnConflictStep struct {
+	s *InsertStmt
+}
+
+// DoNothing ignores the conflicting rows.
+func (c *InsertOnConflictStep) DoNothing() *InsertStmt {
+	c.s.Upsert.Update = nil
+	return c.s
+}
+
+// DoUpdateSet updates the existing rows, Excluded refers to the values proposed for insertion.
+func (c *InsertOnConflictStep) DoUpdateSet(x ...SetClause) *InsertDoUpdateStep {
+	c.s.Upsert.Update = x
+	return &InsertDoUpdateStep{c.s}
+}
+
+type InsertDoUpdateStep struct {
+	*InsertStmt
+}
+
+// Where only updates the existing rows which satisfy the condition.
+//
+// MySQL has no condition on ON DUPLICATE KEY UPDATE, the statement fails there.
+func (s *InsertDoUpdateStep) Where(cond SearchCond) *InsertStmt {
+	s.Upsert.Where = cond
+	return s.InsertStmt
+}
+
+const (
+	kOnConflict             = Keyword("ON CONFLICT")
+	kOnConstraint           = Keyword("ON CONSTRAINT")
+	kDoUpdateSet            = Keyword("DO UPDATE SET")
+	kOnDuplicateKeyUpdate   = Keyword("ON DUPLICATE KEY UPDATE")
+	excludedCorrelationName = "EXCLUDED"
+)
+
+func (c *OnConflictClause) Accept(v Visitor) Visitor {
+	if v.Dialect() == MySQL {
+		return c.onDuplicateKeyUpdate(v)
+	}
+
+	if c.Constraint != nil && v.Dialect() == SQLite {
+		v.Fail(fmt.Errorf("%w: ON CONFLICT ON CONSTRAINT", ErrUnsupported))
+	}
+
+	if c.Update != nil && c.Columns == nil && c.Constraint == nil && v.Dialect() == PostgreSQL {
+		v.Fail(fmt.Errorf("%w: ON CONFLICT DO UPDATE without a conflict target", ErrIncomplete))
+	}
+
+	return v.Visit(kOnConflict).
+		IfNotNil(c.Columns, WS, c.Columns).
+		IfNotNil(c.Constraint, WS, kOnConstraint, WS, c.Constraint).
+		Visit(WS).
+		IfElse(c.Update != nil, AcceptFunc(func(v Visitor) Visitor {
+			return v.Visit(kDoUpdateSet, WS, c.Update).IfNotNil(c.Where, WS, kWhere, WS, accept(c.Where))
+		}), kDoNothing)
+}
+
+// onDuplicateKeyUpdate assigns a column to itself to ignore the conflicting rows.
+//
+// The condition can't be emulated with IF(cond, new, column) in each assignment,
+// MySQL evaluates them from left to right and the later ones would test the updated columns.
+func (c *OnConflictClause) onDuplicateKeyUpdate(v Visitor) Visitor {
+	v.Visit(kOnDuplicateKeyUpdate, WS)
+
+	if c.Update == nil {
+		if len(c.Columns) == 0 {
+			return v.Fail(fmt.Errorf("%w: DO NOTHING without conflict columns", ErrUnsupported))
+		}
+
+		return v.Visit(Ident(Raw(c.Columns[0])), WS, CompEq, WS, Ident(Raw(c.Columns[0])))
+	}
+
+	if c.Where != nil {
+		return v.Fail(fmt.Errorf("%w: %s with a condition", ErrUnsupported, kOnDuplicateKeyUpdate))
+	}
+
+	return v.Visit(c.Update)
+}
+
+func (c *OnConflictClause) String() string { return XQL(c) }
+
+// ExcludedRef refers to the value proposed for insertion in the conflicting row.
+//
+// It is rendered as EXCLUDED.column, or VALUES(column) on MySQL.
+type ExcludedRef ColumnName
+
+func Excluded(name ColumnName) ExcludedRef { return ExcludedRef(name) }
+
+func (r ExcludedRef) expr() Expr { return r }
+func (r ExcludedRef) Accept(v Visitor) Visitor {
+	if v.Dialect() == MySQL {
+		return v.Visit(kValues, Paren(Ident(Raw(r))))
+	}
+
+	return v.Visit(Raw(excludedCorrelationName), Token('.'), Ident(Raw(r)))
+}
+func (r ExcludedRef) String() string { return XQL(r) }
+
+// columnRef is a column qualified by its table.
+type columnRef struct {
+	Table  Accepter
+	Column ColumnName
+}
+
+func (r *columnRef) expr() Expr { return r }
+func (r *columnRef) Accept(v Visitor) Visitor {
+	return v.Visit(r.Table, Token('.'), Ident(Raw(r.Column)))
+}
+func (r *columnRef) String() string { return XQL(r) }
+
+// merge rewrites the upsert into a MERGE statement,
+// whose source is the inserted rows correlated as EXCLUDED.
+func (i *InsertStmt) merge(d Dialect) (*MergeStmt, error) {
+	c := i.Upsert
+
+	if len(c.Columns) == 0 {
+		return nil, fmt.Errorf("%w: ON CONFLICT without conflict columns", ErrUnsupported)
+	}
+
+	var columns ColumnNameList
+	source := &DerivedTable{Correlation: &CorrelationClause{Name: excludedCorrelationName}}
+
+	switch f := i.From.(type) {
+	case *FromConstructor:
+		columns = f.Columns
+
+		if d == Oracle {
+			source.Query = &dualRows{columns, f.Values.rows()}
+		} else {
+			source.Query = f.Values
+		}
+	case *FromSubQuery:
+		columns, source.Query = f.Columns, f.SubQuery
+	default:
+		return nil, fmt.Errorf("%w: ON CONFLICT with %s", ErrUnsupported, i.From)
+	}
+
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("%w: ON CONFLICT without inserted columns", ErrUnsupported)
+	}
+
+	if d != Oracle {
+		source.Correlation.Columns = columns
+	}
+
+	var on []SearchCond
+	for _, col := range c.Columns {
+		on = append(on, Eq(&columnRef{i.Target, col}, Excluded(col)))
+	}
+
+	var values Row
+	for _, col := range columns {
+		values = append(values, Excluded(col))
+	}
+
+	stmt := MergeInto(i.Target).Using(source).On(And(on...))
+	stmt.Return = i.Return
+
+	if c.Update != nil {
+		stmt.When(WhenMatched.And(c.Where).ThenUpdate(c.Update...))
+	}
+
+	return stmt.When(WhenNotMatched.ThenInsert(Columns(columns...).Values(values))), nil
+}
+
+// dualRows selects the rows from DUAL, for Oracle which has no table value constructor.
+type dualRows struct {
+	Columns ColumnNameList
+	Rows    [][]TypedRowValueExpr
+}
+
+const kUnionAll = Keyword("UNION ALL")
+
+func (r *dualRows) Accept(v Visitor) Visitor {
+	for i, row := range r.Rows {
+		v.If(i > 0, WS, kUnionAll, WS).Visit(kSelect, WS)
+
+		for j, value := range row {
+			v.If(j > 0, Sep).Visit(accept(value)).If(j < len(r.Columns), WS, kAs, WS, Ident(Raw(r.Columns[j])))
+		}
+
+		v.Visit(WS, kFromDual)
+	}
+
+	return v
+}
+
+func (r *dualRows) String() string { return XQL(r) }
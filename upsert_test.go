@@ -0,0 +1,65 @@
+package xql_test
+
+import (
+	"fmt"
+
+	. "github.com/flier/xql"
+)
+
+func ExampleInsertStmt_OnConflict() {
+	upsert := InsertInto("distributors").Columns("did", "dname").Values(5, "Gizmo").
+		OnConflict("did").DoUpdateSet(Assign("dname", Excluded("dname"))).
+		Where(Raw("distributors.zipcode <> '21201'"))
+
+	for _, d := range []Dialect{PostgreSQL, SQLServer, Oracle} {
+		fmt.Println(d.XQL(upsert))
+	}
+	// Output:
+	// INSERT INTO distributors (did, dname) VALUES (5, 'Gizmo') ON CONFLICT (did) DO UPDATE SET dname = EXCLUDED.dname WHERE distributors.zipcode <> '21201'
+	// MERGE INTO distributors USING (VALUES (5, 'Gizmo')) AS EXCLUDED (did, dname) ON distributors.did = EXCLUDED.did WHEN MATCHED AND distributors.zipcode <> '21201' THEN UPDATE SET dname = EXCLUDED.dname WHEN NOT MATCHED THEN INSERT (did, dname) VALUES (EXCLUDED.did, EXCLUDED.dname);
+	// MERGE INTO distributors USING (SELECT 5 AS did, 'Gizmo' AS dname FROM DUAL) EXCLUDED ON (distributors.did = EXCLUDED.did) WHEN MATCHED THEN UPDATE SET dname = EXCLUDED.dname WHERE distributors.zipcode <> '21201' WHEN NOT MATCHED THEN INSERT (did, dname) VALUES (EXCLUDED.did, EXCLUDED.dname)
+}
+
+func ExampleInsertDoUpdateStep_Where() {
+	upsert := InsertInto("docs").Columns("id", "body", "version").Values(1, "draft", 2).
+		OnConflict("id").DoUpdateSet(Assign("body", Excluded("body")), Assign("version", Excluded("version"))).
+		Where(Lt(Raw("docs.version"), Excluded("version")))
+
+	fmt.Println(MySQL.XQL(InsertInto("docs").Columns("id", "body").Values(1, "draft").
+		OnConflict("id").DoUpdateSet(Assign("body", Excluded("body")))))
+
+	_, err := MySQL.Build(upsert)
+	fmt.Println(err)
+	_, err = PostgreSQL.Build(InsertInto("docs").Columns("id", "body").Values(1, "draft").
+		OnConflict().DoUpdateSet(Assign("body", Excluded("body"))))
+	fmt.Println(err)
+	// Output:
+	// INSERT INTO docs (id, body) VALUES (1, 'draft') ON DUPLICATE KEY UPDATE body = VALUES(body)
+	// xql: unsupported by the dialect: ON DUPLICATE KEY UPDATE with a condition
+	// xql: incomplete statement: ON CONFLICT DO UPDATE without a conflict target
+}
+
+func ExampleInsertStmt_OnConflict_doNothing() {
+	ignore := InsertInto("distributors").Columns("did", "dname").Values(Row{7, "Redline"}, Row{8, "Anvil"}).
+		OnConflict("did").DoNothing()
+
+	for _, d := range []Dialect{SQLite, MySQL, SQLServer, Oracle} {
+		fmt.Println(d.XQL(ignore))
+	}
+
+	_, err := SQLite.Build(InsertInto("distributors").Columns("did").Values(9).
+		OnConflictOnConstraint("distributors_pkey").DoNothing())
+	fmt.Println(err)
+	// Output:
+	// INSERT INTO distributors (did, dname) VALUES
+	// 	(7, 'Redline'),
+	// 	(8, 'Anvil') ON CONFLICT (did) DO NOTHING
+	// INSERT INTO distributors (did, dname) VALUES
+	// 	(7, 'Redline'),
+	// 	(8, 'Anvil') ON DUPLICATE KEY UPDATE did = did
+	// MERGE INTO distributors USING (VALUES
+	// 	(7, 'Redline'),
+	// 	(8, 'Anvil')) AS EXCLUDED (did, dname) ON distributors.did = EXCLUDED.did WHEN NOT MATCHED THEN INSERT (did, dname) VALUES (EXCLUDED.did, EXCLUDED.dname);
+	// MERGE INTO distributors USING (SELECT 7 AS did, 'Redline' AS dname FROM DUAL UNION ALL SELECT 8 AS did, 'Anvil' AS dname FROM DUAL) EXCLUDED ON (distributors.did = EXCLUDED.did) WHEN NOT MATCHED THEN INSERT (did, dname) VALUES (EXCLUDED.did, EXCLUDED.dname)
+	// xql: unsupported by the dialect: ON CONFLICT ON CONSTRAINT
+}
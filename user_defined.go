@@ -1,8 +1,417 @@
 package xql
 
+import (
+	"fmt"
+)
+
 type UserDefinedTypeName = SchemaQualifiedName
 
 var _ DataType = &UserDefinedTypeName{}
 
 func (n *UserDefinedTypeName) dataType() DataType          { return n }
 func (n *UserDefinedTypeName) applyColumnDef(d *ColumnDef) { d.Type = n }
+
+// TypeDef defines a user-defined type.
+//
+//	<user-defined type definition> ::=
+//		CREATE TYPE <user-defined type name> [ UNDER <supertype name> ]
+//		[ AS <representation> ] [ <user-defined type option list> ] [ <method specification list> ]
+//
+// PostgreSQL defines enum, composite and range types, Oracle defines object types.
+//
+// https://jakewheat.github.io/sql-overview/sql-2016-foundation-grammar.html#user-defined-type-definition
+type TypeDef struct {
+	Name         *UserDefinedTypeName
+	Super        *UserDefinedTypeName
+	Labels       []string
+	Attributes   []*ColumnDef
+	Range        *RangeTypeSpec
+	Instantiable *bool
+	Final        *bool
+	Methods      []*MethodSpec
+}
+
+// CreateType defines a user-defined type.
+func CreateType[T ToSchemaQualifiedName](name T) *TypeDef {
+	return &TypeDef{Name: SchemaQName(name)}
+}
+
+// AsEnum defines an enumerated type with the labels.
+func (d *TypeDef) AsEnum(labels ...string) *TypeDef {
+	d.Labels = labels
+	return d
+}
+
+// As defines a composite or structured type with the attributes.
+func (d *TypeDef) As(x ...*ColumnDef) *TypeDef {
+	d.Attributes = x
+	return d
+}
+
+// AsRange defines a range type over the subtype, with options such as SUBTYPE_DIFF.
+func (d *TypeDef) AsRange(subtype ToDataType, x ...*StorageParam) *TypeDef {
+	d.Range = &RangeTypeSpec{subtype.dataType(), x}
+	return d
+}
+
+// Under makes the type a subtype of the supertype.
+func (d *TypeDef) Under(super string) *TypeDef {
+	d.Super = SchemaQName(super)
+	return d
+}
+
+// Method adds a method to the type.
+func (d *TypeDef) Method(x ...*MethodSpec) *TypeDef {
+	d.Methods = append(d.Methods, x...)
+	return d
+}
+
+// NotInstantiable only allows instances of the subtypes.
+func (d *TypeDef) NotInstantiable() *TypeDef {
+	instantiable := false
+	d.Instantiable = &instantiable
+	return d
+}
+
+// NotFinal allows subtypes of the type.
+func (d *TypeDef) NotFinal() *TypeDef {
+	final := false
+	d.Final = &final
+	return d
+}
+
+// IsFinal does not allow subtypes of the type.
+func (d *TypeDef) IsFinal() *TypeDef {
+	final := true
+	d.Final = &final
+	return d
+}
+
+const (
+	kUnder           = Keyword("UNDER")
+	kAsEnum          = Keyword("AS ENUM")
+	kAsRange         = Keyword("AS RANGE")
+	kAsObject        = Keyword("AS OBJECT")
+	kInstantiable    = Keyword("INSTANTIABLE")
+	kNotInstantiable = Keyword("NOT INSTANTIABLE")
+	kFinal           = Keyword("FINAL")
+	kNotFinal        = Keyword("NOT FINAL")
+)
+
+func (d *TypeDef) Accept(v Visitor) Visitor {
+	dialect := v.Dialect()
+
+	only(v, "CREATE TYPE", PostgreSQL, Oracle)
+
+	if d.Labels != nil || d.Range != nil {
+		only(v, "CREATE TYPE AS ENUM/RANGE", PostgreSQL)
+	}
+	if d.Super != nil || d.Instantiable != nil || d.Final != nil || d.Methods != nil {
+		only(v, "CREATE TYPE UNDER/INSTANTIABLE/FINAL/METHOD", Oracle)
+	}
+
+	v.Visit(kCreateType, WS, d.Name).IfNotNil(d.Super, WS, kUnder, WS, d.Super)
+
+	labels := make([]Accepter, len(d.Labels))
+	for i, l := range d.Labels {
+		labels[i] = literal(l)
+	}
+
+	members := accepts(d.Attributes)
+	if dialect == Oracle {
+		// Oracle declares the methods with the attributes.
+		members = append(members, accepts(d.Methods)...)
+	}
+
+	switch {
+	case d.Labels != nil:
+		v.Visit(WS, kAsEnum, WS, Paren(Joins(labels, Sep)))
+	case d.Range != nil:
+		v.Visit(WS, kAsRange, WS, d.Range)
+	case dialect == Oracle && d.Super != nil:
+		v.Visit(WS, Paren(Joins(members, Sep)))
+	case dialect == Oracle:
+		v.Visit(WS, kAsObject, WS, Paren(Joins(members, Sep)))
+	case d.Attributes != nil:
+		v.Visit(WS, kAs, WS, Paren(Joins(members, Sep)))
+	}
+
+	v.IfNotNil(d.Instantiable, WS, AcceptFunc(func(v Visitor) Visitor {
+		return v.IfElse(*d.Instantiable, kInstantiable, kNotInstantiable)
+	})).IfNotNil(d.Final, WS, AcceptFunc(func(v Visitor) Visitor {
+		return v.IfElse(*d.Final, kFinal, kNotFinal)
+	}))
+
+	return v.If(dialect != Oracle && d.Methods != nil, WS, Joins(d.Methods, Sep))
+}
+
+func (d *TypeDef) String() string { return XQL(d) }
+
+// RangeTypeSpec is the representation of a range type.
+//
+//	( SUBTYPE = <subtype> [, <option> = <value> ...] )
+type RangeTypeSpec struct {
+	Subtype DataType
+	Options []*StorageParam
+}
+
+func (s *RangeTypeSpec) Accept(v Visitor) Visitor {
+	subtype := &StorageParam{"SUBTYPE", s.Subtype}
+
+	return v.Visit(Paren(Joins(append([]*StorageParam{subtype}, s.Options...), Sep)))
+}
+
+func (s *RangeTypeSpec) String() string { return XQL(s) }
+
+//go:generate stringer -type MethodKind -linecomment
+
+// MethodKind tells how a method of a structured type is invoked.
+type MethodKind int
+
+const (
+	InstanceMethod    MethodKind = iota // INSTANCE
+	StaticMethod                        // STATIC
+	ConstructorMethod                   // CONSTRUCTOR
+)
+
+// MethodSpec declares a method of a structured type.
+//
+//	<original method specification> ::=
+//		[ INSTANCE | STATIC | CONSTRUCTOR ] METHOD <method name> <SQL parameter declaration list>
+//		RETURNS <data type>
+//
+// It is rendered as a MEMBER or STATIC FUNCTION on Oracle.
+type MethodSpec struct {
+	Kind    MethodKind
+	Name    string
+	Params  []*ColumnDef
+	Returns DataType
+}
+
+// Method declares an instance method with the parameters.
+func Method(name string, params ...*ColumnDef) *MethodSpec {
+	return &MethodSpec{Name: name, Params: params}
+}
+
+// Static makes the method a static method.
+func (m *MethodSpec) Static() *MethodSpec {
+	m.Kind = StaticMethod
+	return m
+}
+
+// Constructor makes the method a constructor of the type.
+func (m *MethodSpec) Constructor() *MethodSpec {
+	m.Kind = ConstructorMethod
+	return m
+}
+
+// Return sets the type of the result of the method.
+func (m *MethodSpec) Return(t ToDataType) *MethodSpec {
+	m.Returns = t.dataType()
+	return m
+}
+
+const (
+	kMethod          = Keyword("METHOD")
+	kReturns         = Keyword("RETURNS")
+	kMemberFunction  = Keyword("MEMBER FUNCTION")
+	kStaticFunction  = Keyword("STATIC FUNCTION")
+	kConstructorFunc = Keyword("CONSTRUCTOR FUNCTION")
+	kReturn          = Keyword("RETURN")
+	kSelfAsResult    = Keyword("SELF AS RESULT")
+)
+
+func (m *MethodSpec) Accept(v Visitor) Visitor {
+	params := AcceptFunc(func(v Visitor) Visitor {
+		return v.If(len(m.Params) > 0 || v.Dialect() != Oracle, WS, Paren(Joins(m.Params, Sep)))
+	})
+	returns := AcceptFunc(func(v Visitor) Visitor { return v.DataType(m.Returns) })
+
+	if v.Dialect() != Oracle {
+		return v.If(m.Kind != InstanceMethod, Keyword(m.Kind.String()), WS).
+			Visit(kMethod, WS, Ident(Raw(m.Name)), params).
+			IfNotNil(m.Returns, WS, kReturns, WS, returns)
+	}
+
+	switch m.Kind {
+	case ConstructorMethod:
+		return v.Visit(kConstructorFunc, WS, Ident(Raw(m.Name)), params, WS, kReturn, WS, kSelfAsResult)
+	case StaticMethod:
+		v.Visit(kStaticFunction)
+	default:
+		v.Visit(kMemberFunction)
+	}
+
+	return v.Visit(WS, Ident(Raw(m.Name)), params).IfNotNil(m.Returns, WS, kReturn, WS, returns)
+}
+
+func (m *MethodSpec) String() string { return XQL(m) }
+
+// AlterTypeStmt changes the definition of a user-defined type.
+//
+//	<alter type statement> ::= ALTER TYPE <schema-resolved user-defined type name> <alter type action>
+//
+// PostgreSQL applies a list of attribute actions in a single statement, the other actions are rendered as their
+// own statements, separated by ";\n".
+//
+// https://jakewheat.github.io/sql-overview/sql-2016-foundation-grammar.html#alter-type-statement
+type AlterTypeStmt struct {
+	Name    *UserDefinedTypeName
+	Actions []*AlterTypeAction
+}
+
+// AlterTypeAction is an action of the ALTER TYPE statement.
+type AlterTypeAction struct {
+	Attribute bool
+	Dialects  []Dialect
+	Action    Accepter
+}
+
+// AlterType changes the definition of a user-defined type.
+func AlterType[T ToSchemaQualifiedName](name T) *AlterTypeStmt {
+	return &AlterTypeStmt{Name: SchemaQName(name)}
+}
+
+func (s *AlterTypeStmt) action(attribute bool, x Accepter, dialects ...Dialect) *AlterTypeStmt {
+	s.Actions = append(s.Actions, &AlterTypeAction{attribute, dialects, x})
+	return s
+}
+
+const (
+	kAddValue        = Keyword("ADD VALUE")
+	kBefore          = Keyword("BEFORE")
+	kAfter           = Keyword("AFTER")
+	kRenameValue     = Keyword("RENAME VALUE")
+	kAddAttribute    = Keyword("ADD ATTRIBUTE")
+	kDropAttribute   = Keyword("DROP ATTRIBUTE")
+	kAlterAttribute  = Keyword("ALTER ATTRIBUTE")
+	kRenameAttribute = Keyword("RENAME ATTRIBUTE")
+	kAddMethod       = Keyword("ADD")
+	kDropMethod      = Keyword("DROP")
+)
+
+// AddValue adds a label to an enumerated type.
+func (s *AlterTypeStmt) AddValue(label string) *AlterTypeStmt {
+	return s.action(false, AcceptFunc(func(v Visitor) Visitor {
+		return v.Visit(kAddValue, WS, literal(label))
+	}), PostgreSQL)
+}
+
+// AddValueBefore adds a label to an enumerated type, before an existing label.
+func (s *AlterTypeStmt) AddValueBefore(label, before string) *AlterTypeStmt {
+	return s.action(false, AcceptFunc(func(v Visitor) Visitor {
+		return v.Visit(kAddValue, WS, literal(label), WS, kBefore, WS, literal(before))
+	}), PostgreSQL)
+}
+
+// AddValueAfter adds a label to an enumerated type, after an existing label.
+func (s *AlterTypeStmt) AddValueAfter(label, after string) *AlterTypeStmt {
+	return s.action(false, AcceptFunc(func(v Visitor) Visitor {
+		return v.Visit(kAddValue, WS, literal(label), WS, kAfter, WS, literal(after))
+	}), PostgreSQL)
+}
+
+// RenameValue renames a label of an enumerated type.
+func (s *AlterTypeStmt) RenameValue(label, to string) *AlterTypeStmt {
+	return s.action(false, AcceptFunc(func(v Visitor) Visitor {
+		return v.Visit(kRenameValue, WS, literal(label), WS, kTo, WS, literal(to))
+	}), PostgreSQL)
+}
+
+// AddAttribute adds an attribute to a composite or structured type.
+func (s *AlterTypeStmt) AddAttribute(c *ColumnDef) *AlterTypeStmt {
+	return s.action(true, AcceptFunc(func(v Visitor) Visitor {
+		return v.Visit(kAddAttribute, WS).IfElse(v.Dialect() == Oracle, Paren(c), c)
+	}), PostgreSQL, Oracle)
+}
+
+// DropAttribute drops an attribute of a composite or structured type.
+func (s *AlterTypeStmt) DropAttribute(name string, x ...DropBehavior) *AlterTypeStmt {
+	b := dropBehavior(x)
+
+	return s.action(true, AcceptFunc(func(v Visitor) Visitor {
+		return acceptDropBehavior(v.Visit(kDropAttribute, WS, Ident(Raw(name))), "DROP ATTRIBUTE", b, PostgreSQL)
+	}), PostgreSQL, Oracle)
+}
+
+// AlterAttributeType changes the data type of an attribute of a composite type.
+func (s *AlterTypeStmt) AlterAttributeType(name string, t ToDataType) *AlterTypeStmt {
+	dt := t.dataType()
+
+	return s.action(true, AcceptFunc(func(v Visitor) Visitor {
+		return v.Visit(kAlterAttribute, WS, Ident(Raw(name)), WS, kSetDataType, WS,
+			AcceptFunc(func(v Visitor) Visitor { return v.DataType(dt) }))
+	}), PostgreSQL)
+}
+
+// RenameAttribute renames an attribute of a composite type.
+func (s *AlterTypeStmt) RenameAttribute(name, to string) *AlterTypeStmt {
+	return s.action(false, AcceptFunc(func(v Visitor) Visitor {
+		return v.Visit(kRenameAttribute, WS, Ident(Raw(name)), WS, kTo, WS, Ident(Raw(to)))
+	}), PostgreSQL)
+}
+
+// AddMethod adds a method to a structured type.
+func (s *AlterTypeStmt) AddMethod(m *MethodSpec) *AlterTypeStmt {
+	return s.action(false, AcceptFunc(func(v Visitor) Visitor {
+		return v.Visit(kAddMethod, WS, m)
+	}), Oracle)
+}
+
+// DropMethod drops a method of a structured type.
+func (s *AlterTypeStmt) DropMethod(m *MethodSpec) *AlterTypeStmt {
+	return s.action(false, AcceptFunc(func(v Visitor) Visitor {
+		return v.Visit(kDropMethod, WS, m)
+	}), Oracle)
+}
+
+// RenameTo renames the type.
+func (s *AlterTypeStmt) RenameTo(name string) *AlterTypeStmt {
+	return s.action(false, &RenameTableAction{name}, PostgreSQL)
+}
+
+// Stmts splits the statement into the statements needed by the dialect to apply all the actions.
+func (s *AlterTypeStmt) Stmts(d Dialect) []*AlterTypeStmt {
+	if len(s.Actions) < 2 {
+		return []*AlterTypeStmt{s}
+	}
+
+	var stmts []*AlterTypeStmt
+	var last *AlterTypeStmt
+
+	for _, a := range s.Actions {
+		if d == PostgreSQL && a.Attribute && last != nil {
+			last.Actions = append(last.Actions, a)
+			continue
+		}
+
+		stmt := &AlterTypeStmt{Name: s.Name, Actions: []*AlterTypeAction{a}}
+		stmts = append(stmts, stmt)
+
+		if d == PostgreSQL && a.Attribute {
+			last = stmt
+		}
+	}
+
+	return stmts
+}
+
+const kAlterType = Keyword("ALTER TYPE")
+
+func (s *AlterTypeStmt) Accept(v Visitor) Visitor {
+	for i, stmt := range s.Stmts(v.Dialect()) {
+		v = v.If(i > 0, Raw(";\n")).Visit(kAlterType, WS, stmt.Name, WS, Joins(stmt.Actions, Sep))
+	}
+
+	return v
+}
+
+func (s *AlterTypeStmt) String() string { return XQL(s) }
+
+func (a *AlterTypeAction) Accept(v Visitor) Visitor {
+	if v.Dialect() != StandardSQL && !v.Dialect().Is(a.Dialects...) {
+		v.Fail(fmt.Errorf("%w: ALTER TYPE %s", ErrUnsupported, XQL(a.Action)))
+	}
+
+	return v.Visit(a.Action)
+}
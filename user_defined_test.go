@@ -0,0 +1,80 @@
+package xql_test
+
+import (
+	"fmt"
+
+	. "github.com/flier/xql"
+)
+
+func ExampleCreateType() {
+	fmt.Println(PostgreSQL.XQL(CreateType("mood").AsEnum("sad", "ok", "happy")))
+	fmt.Println(PostgreSQL.XQL(CreateType("inventory_item").As(
+		Column("name", Text),
+		Column("supplier_id", Integer),
+		Column("price", Numeric),
+	)))
+	fmt.Println(PostgreSQL.XQL(CreateType("floatrange").AsRange(DoublePrecision, &StorageParam{"SUBTYPE_DIFF", Raw("float8mi")})))
+
+	_, err := MySQL.Build(CreateType("mood").AsEnum("sad", "ok", "happy"))
+	fmt.Println(err)
+	// Output:
+	// CREATE TYPE mood AS ENUM ('sad', 'ok', 'happy')
+	// CREATE TYPE inventory_item AS (name TEXT, supplier_id INTEGER, price NUMERIC)
+	// CREATE TYPE floatrange AS RANGE (SUBTYPE = DOUBLE PRECISION, SUBTYPE_DIFF = float8mi)
+	// xql: unsupported by the dialect: CREATE TYPE
+}
+
+func ExampleCreateType_structured() {
+	person := CreateType("person_t").As(
+		Column("name", VarChar(30)),
+		Column("phone", VarChar(20)),
+	).Method(Method("age").Return(Integer)).NotInstantiable().NotFinal()
+
+	employee := CreateType("employee_t").Under("person_t").As(
+		Column("salary", Numeric),
+	).Method(Method("raise", Column("pct", Numeric)).Return(Numeric)).IsFinal()
+
+	fmt.Println(person)
+	fmt.Println(employee)
+	fmt.Println(Oracle.XQL(person))
+	fmt.Println(Oracle.XQL(employee))
+	fmt.Println(CreateTable("employees").Of("employee_t", PrimaryKey("name")))
+
+	_, err := PostgreSQL.Build(employee)
+	fmt.Println(err)
+	// Output:
+	// CREATE TYPE person_t AS (name VARCHAR(30), phone VARCHAR(20)) NOT INSTANTIABLE NOT FINAL METHOD age () RETURNS INTEGER
+	// CREATE TYPE employee_t UNDER person_t AS (salary NUMERIC) FINAL METHOD raise (pct NUMERIC) RETURNS NUMERIC
+	// CREATE TYPE person_t AS OBJECT (name VARCHAR(30), phone VARCHAR(20), MEMBER FUNCTION age RETURN INTEGER) NOT INSTANTIABLE NOT FINAL
+	// CREATE TYPE employee_t UNDER person_t (salary NUMERIC, MEMBER FUNCTION raise (pct NUMERIC) RETURN NUMERIC) FINAL
+	// CREATE TABLE employees OF employee_t (
+	// 	PRIMARY KEY (name)
+	// )
+	// xql: unsupported by the dialect: CREATE TYPE UNDER/INSTANTIABLE/FINAL/METHOD
+}
+
+func ExampleAlterType() {
+	fmt.Println(PostgreSQL.XQL(AlterType("mood").AddValue("meh").AddValueBefore("angry", "sad").RenameValue("ok", "fine")))
+	fmt.Println(PostgreSQL.XQL(AlterType("inventory_item").
+		AddAttribute(Column("qty", Integer)).
+		DropAttribute("price", DropCascade).
+		AlterAttributeType("name", VarChar(50)).
+		RenameAttribute("supplier_id", "vendor_id").
+		RenameTo("item")))
+	fmt.Println(Oracle.XQL(AlterType("person_t").
+		AddAttribute(Column("email", VarChar(80))).
+		AddMethod(Method("greet").Return(VarChar(80)))))
+
+	_, err := Oracle.Build(AlterType("mood").AddValue("meh"))
+	fmt.Println(err)
+	// Output:
+	// ALTER TYPE mood ADD VALUE 'meh';
+	// ALTER TYPE mood ADD VALUE 'angry' BEFORE 'sad';
+	// ALTER TYPE mood RENAME VALUE 'ok' TO 'fine'
+	// ALTER TYPE inventory_item ADD ATTRIBUTE qty INTEGER, DROP ATTRIBUTE price CASCADE, ALTER ATTRIBUTE name SET DATA TYPE VARCHAR(50);
+	// ALTER TYPE inventory_item RENAME ATTRIBUTE supplier_id TO vendor_id;
+	// ALTER TYPE inventory_item RENAME TO item
+	// ALTER TYPE person_t ADD ATTRIBUTE (email VARCHAR(80));
+	// ALTER TYPE person_t ADD MEMBER FUNCTION greet RETURN VARCHAR(80)
+	// xql: unsupported by the dialect: ALTER TYPE ADD VALUE 'meh'
+}
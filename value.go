@@ -3,7 +3,10 @@ package xql
 import (
 	"encoding/hex"
 	"fmt"
+	"reflect"
 	"strconv"
+	"strings"
+	"time"
 )
 
 type ValueExpr interface {
@@ -47,8 +50,11 @@ var (
 	_ TypedRowValueExpr = int64Value(0)
 	_ TypedRowValueExpr = intValue(0)
 	_ TypedRowValueExpr = floatValue(0)
+	_ TypedRowValueExpr = timeValue{}
 	_ TypedRowValueExpr = &anyValue{}
 	_ TypedRowValueExpr = &DefaultSpec{}
+	_ TypedRowValueExpr = ArrayValue(nil)
+	_ TypedRowValueExpr = &SubQuery{}
 )
 
 func newTypedRowValueExpr(value any) TypedRowValueExpr {
@@ -102,6 +108,16 @@ func newTypedRowValueExpr(value any) TypedRowValueExpr {
 	case float64:
 		return floatValue(v)
 
+	case time.Time:
+		return timeValue(v)
+
+	case *time.Time:
+		if v == nil {
+			return Nil
+		}
+
+		return timeValue(*v)
+
 	case Row:
 		row := make(rowValue, len(v))
 
@@ -123,7 +139,24 @@ func newTypedRowValueExpr(value any) TypedRowValueExpr {
 	case *DefaultSpec:
 		return v
 
+	case SelectQuery:
+		return &SubQuery{v.Query()}
+
+	case ToExpr:
+		return v.expr()
+
 	default:
+		switch rv := reflect.ValueOf(v); rv.Kind() {
+		case reflect.Slice:
+			if rv.IsNil() {
+				return Nil
+			}
+
+			return newArrayValue(rv)
+		case reflect.Array:
+			return newArrayValue(rv)
+		}
+
 		return &anyValue{v}
 	}
 }
@@ -141,10 +174,20 @@ func (v boolValue) expr() Expr                   { return v }
 func (v boolValue) boolValueExpr() BoolValueExpr { return v }
 func (v boolValue) String() string               { return strconv.FormatBool(bool(v)) }
 
+// strValue is rendered as a character string literal,
+// whose backslashes are doubled on MySQL where they start escape sequences.
 type strValue string
 
-func (v strValue) expr() Expr     { return v }
-func (v strValue) String() string { return strconv.Quote(string(v)) }
+func (v strValue) expr() Expr { return v }
+func (v strValue) Accept(x Visitor) Visitor {
+	s := string(v)
+	if x.Dialect() == MySQL {
+		s = strings.ReplaceAll(s, `\`, `\\`)
+	}
+
+	return x.Raw(quoteLiteral(s))
+}
+func (v strValue) String() string { return XQL(v) }
 
 type binValue []byte
 
@@ -222,6 +265,19 @@ func (v floatValue) expr() Expr                       { return v }
 func (v floatValue) numberValueExpr() NumberValueExpr { return v }
 func (v floatValue) String() string                   { return strconv.FormatFloat(float64(v), 'g', -1, 64) }
 
+// timeValue is rendered as a timestamp literal in the location of the time,
+// or as a character string literal on SQLite and SQL Server which convert it implicitly.
+type timeValue time.Time
+
+const kTimestamp = Keyword("TIMESTAMP")
+
+func (v timeValue) expr() Expr { return v }
+func (v timeValue) Accept(x Visitor) Visitor {
+	return x.If(!x.Dialect().Is(SQLite, SQLServer), kTimestamp, WS).
+		Raw(quoteLiteral(time.Time(v).Format("2006-01-02 15:04:05.999999")))
+}
+func (v timeValue) String() string { return XQL(v) }
+
 type anyValue struct{ any }
 
 func (v anyValue) expr() Expr     { return v }
@@ -229,17 +285,37 @@ func (v anyValue) String() string { return fmt.Sprintf("%v", v.any) }
 
 type Row []any
 
+// rowValue is a row value constructor, it is rendered as the parenthesized list of its elements.
+//
+//	<explicit row value constructor> ::=
+//		<left paren> <row value constructor element> <comma> <row value constructor element list> <right paren>
+//		| ROW <left paren> <row value constructor element list> <right paren>
 type rowValue []TypedRowValueExpr
 
-func (v rowValue) expr() Expr     { return v }
-func (v rowValue) String() string { return fmt.Sprintf("ROW(%s)", Join(v, ", ")) }
+const kRow = Keyword("ROW")
+
+func (v rowValue) expr() Expr               { return v }
+func (v rowValue) Accept(x Visitor) Visitor { return x.Visit(Paren(Joins(accepts(v), Sep))) }
+func (v rowValue) String() string           { return XQL(v) }
+
+// explicit renders the row with the ROW keyword in the standard dialect.
+func (v rowValue) explicit() Accepter {
+	return AcceptFunc(func(x Visitor) Visitor { return x.If(x.Dialect() == StandardSQL, kRow).Visit(v) })
+}
 
 type Rows [][]any
 
 type rowsValue []rowValue
 
-func (v rowsValue) expr() Expr     { return v }
-func (v rowsValue) String() string { return "\n\t" + Join(v, ",\n\t") }
+func (v rowsValue) expr() Expr { return v }
+func (v rowsValue) Accept(x Visitor) Visitor {
+	for i, r := range v {
+		x.IfElse(i > 0, Raw(",\n\t"), Raw("\n\t")).Visit(r.explicit())
+	}
+
+	return x
+}
+func (v rowsValue) String() string { return XQL(v) }
 
 type CallExpr struct {
 	Name string
@@ -266,6 +342,8 @@ func Func(name string) CallFunc {
 
 func (e *CallExpr) expr() Expr { return e }
 
-func (e *CallExpr) String() string {
-	return fmt.Sprintf("%s(%s)", e.Name, Join(e.Args, ", "))
+func (e *CallExpr) Accept(v Visitor) Visitor {
+	return v.Raw(e.Name).Visit(Paren(Joins(accepts(e.Args), Sep)))
 }
+
+func (e *CallExpr) String() string { return XQL(e) }
@@ -0,0 +1,291 @@
+package xql
+
+import (
+	"fmt"
+)
+
+//go:generate stringer -type ViewCheckOption -linecomment
+
+// ViewCheckOption prohibits changes through an updatable view that would produce rows the view does not select.
+type ViewCheckOption int
+
+const (
+	// The levels of the check are left to the default, which is CASCADED.
+	CheckOption ViewCheckOption = iota // WITH CHECK OPTION
+	// The conditions of the view and of all the underlying views are checked.
+	CascadedCheckOption // WITH CASCADED CHECK OPTION
+	// Only the conditions of the view are checked.
+	LocalCheckOption // WITH LOCAL CHECK OPTION
+)
+
+func (o ViewCheckOption) Accept(v Visitor) Visitor {
+	if o != CheckOption && v.Dialect().Is(SQLServer, Oracle) {
+		if o == LocalCheckOption {
+			return v.Fail(fmt.Errorf("%w: %s", ErrUnsupported, o))
+		}
+
+		o = CheckOption
+	}
+
+	return v.Keyword(o)
+}
+
+// ViewDef defines a view.
+//
+//	<view definition> ::=
+//		CREATE [ RECURSIVE ] VIEW <table name> <view specification>
+//		AS <query expression> [ WITH [ <levels clause> ] CHECK OPTION ]
+//
+// OR REPLACE is rendered as OR ALTER on SQL Server.
+//
+// https://jakewheat.github.io/sql-overview/sql-2016-foundation-grammar.html#view-definition
+type ViewDef struct {
+	Replace        bool
+	Scope          *TableScope
+	RecursiveQuery bool
+	Name           *TableName
+	Columns        ColumnNameList
+	Query          QueryExprBody
+	Check          *ViewCheckOption
+	ReadOnly       bool
+}
+
+// CreateView defines a view with the columns.
+func CreateView[T ToTableName](name T, x ...ColumnName) *ViewDef {
+	return &ViewDef{Name: newTableName(name), Columns: x}
+}
+
+// As sets the query of the view.
+func (d *ViewDef) As(q SelectQuery) *ViewDef {
+	d.Query = q.Query()
+	return d
+}
+
+// OrReplace replaces the view if it already exists.
+func (d *ViewDef) OrReplace() *ViewDef {
+	d.Replace = true
+	return d
+}
+
+// Temporary drops the view at the end of the session.
+func (d *ViewDef) Temporary() *ViewDef {
+	temp := true
+	d.Scope = &TableScope{Temporary: &temp}
+	return d
+}
+
+// Recursive defines a view with a recursive query, the columns of the view are required.
+func (d *ViewDef) Recursive() *ViewDef {
+	d.RecursiveQuery = true
+	return d
+}
+
+func (d *ViewDef) checkOption(o ViewCheckOption) *ViewDef {
+	d.Check = &o
+	return d
+}
+
+// WithCheckOption prohibits changes through the view that would produce rows the view does not select.
+func (d *ViewDef) WithCheckOption() *ViewDef { return d.checkOption(CheckOption) }
+
+// WithCascadedCheckOption checks the conditions of the view and of all the underlying views.
+func (d *ViewDef) WithCascadedCheckOption() *ViewDef { return d.checkOption(CascadedCheckOption) }
+
+// WithLocalCheckOption only checks the conditions of the view.
+func (d *ViewDef) WithLocalCheckOption() *ViewDef { return d.checkOption(LocalCheckOption) }
+
+// WithReadOnly prohibits changes through the view, it is supported by Oracle.
+func (d *ViewDef) WithReadOnly() *ViewDef {
+	d.ReadOnly = true
+	return d
+}
+
+const (
+	kOrReplace = Keyword("OR REPLACE")
+	kOrAlter   = Keyword("OR ALTER")
+)
+
+func (d *ViewDef) Accept(v Visitor) Visitor {
+	if d.Query == nil {
+		return v.Fail(fmt.Errorf("%w: CREATE VIEW without the query", ErrIncomplete))
+	}
+	if d.RecursiveQuery && len(d.Columns) == 0 {
+		return v.Fail(fmt.Errorf("%w: CREATE RECURSIVE VIEW without the columns", ErrIncomplete))
+	}
+	if d.Replace && v.Dialect() == SQLite {
+		v.Fail(fmt.Errorf("%w: CREATE OR REPLACE VIEW", ErrUnsupported))
+	}
+	if d.Scope != nil {
+		only(v, "CREATE TEMPORARY VIEW", PostgreSQL, SQLite)
+	}
+	if d.RecursiveQuery {
+		only(v, "CREATE RECURSIVE VIEW", PostgreSQL)
+	}
+	if d.Check != nil && v.Dialect() == SQLite {
+		v.Fail(fmt.Errorf("%w: %s", ErrUnsupported, d.Check))
+	}
+	if d.ReadOnly {
+		only(v, "WITH READ ONLY", Oracle)
+	}
+
+	return v.Visit(kCreate).
+		If(d.Replace, WS, AcceptFunc(func(v Visitor) Visitor {
+			return v.IfElse(v.Dialect() == SQLServer, kOrAlter, kOrReplace)
+		})).
+		IfNotNil(d.Scope, WS, accept(d.Scope)).
+		If(d.RecursiveQuery, WS, kRecursive).
+		Visit(WS, kView, WS, d.Name).
+		IfNotNil(d.Columns, WS, d.Columns).
+		Visit(WS, kAs, WS, accept(d.Query)).
+		IfNotNil(d.Check, WS, d.Check).
+		If(d.ReadOnly, WS, kWithReadOnly)
+}
+
+func (d *ViewDef) String() string { return XQL(d) }
+
+// MaterializedViewDef defines a materialized view, whose query result is stored.
+//
+//	CREATE MATERIALIZED VIEW [ IF NOT EXISTS ] <table name> [ ( <column name> [, ...] ) ]
+//		AS <query expression> [ WITH [ NO ] DATA ]
+//
+// It is rendered with BUILD IMMEDIATE or BUILD DEFERRED on Oracle.
+type MaterializedViewDef struct {
+	SkipExisting bool
+	Name         *TableName
+	Columns      ColumnNameList
+	Query        QueryExprBody
+	Data         *bool
+}
+
+// CreateMaterializedView defines a materialized view with the columns.
+func CreateMaterializedView[T ToTableName](name T, x ...ColumnName) *MaterializedViewDef {
+	return &MaterializedViewDef{Name: newTableName(name), Columns: x}
+}
+
+// As sets the query of the materialized view.
+func (d *MaterializedViewDef) As(q SelectQuery) *MaterializedViewDef {
+	d.Query = q.Query()
+	return d
+}
+
+// IfNotExists does not fail if a materialized view with the same name already exists.
+func (d *MaterializedViewDef) IfNotExists() *MaterializedViewDef {
+	d.SkipExisting = true
+	return d
+}
+
+// WithData populates the materialized view when it is created.
+func (d *MaterializedViewDef) WithData() *MaterializedViewDef {
+	data := true
+	d.Data = &data
+	return d
+}
+
+// WithNoData leaves the materialized view unpopulated until it is refreshed.
+func (d *MaterializedViewDef) WithNoData() *MaterializedViewDef {
+	data := false
+	d.Data = &data
+	return d
+}
+
+const (
+	kCreateMaterializedView = Keyword("CREATE MATERIALIZED VIEW")
+	kWithData               = Keyword("WITH DATA")
+	kWithNoData             = Keyword("WITH NO DATA")
+	kBuildImmediate         = Keyword("BUILD IMMEDIATE")
+	kBuildDeferred          = Keyword("BUILD DEFERRED")
+)
+
+func (d *MaterializedViewDef) Accept(v Visitor) Visitor {
+	only(v, "CREATE MATERIALIZED VIEW", PostgreSQL, Oracle)
+
+	if d.Query == nil {
+		return v.Fail(fmt.Errorf("%w: CREATE MATERIALIZED VIEW without the query", ErrIncomplete))
+	}
+
+	if d.SkipExisting {
+		only(v, "CREATE MATERIALIZED VIEW IF NOT EXISTS", PostgreSQL)
+	}
+
+	v.Visit(kCreateMaterializedView).
+		If(d.SkipExisting, WS, kIfNotExists).
+		Visit(WS, d.Name).
+		IfNotNil(d.Columns, WS, d.Columns)
+
+	if v.Dialect() == Oracle {
+		return v.IfNotNil(d.Data, WS, AcceptFunc(func(v Visitor) Visitor {
+			return v.IfElse(*d.Data, kBuildImmediate, kBuildDeferred)
+		})).Visit(WS, kAs, WS, accept(d.Query))
+	}
+
+	return v.Visit(WS, kAs, WS, accept(d.Query)).
+		IfNotNil(d.Data, WS, AcceptFunc(func(v Visitor) Visitor {
+			return v.IfElse(*d.Data, kWithData, kWithNoData)
+		}))
+}
+
+func (d *MaterializedViewDef) String() string { return XQL(d) }
+
+// RefreshMaterializedViewStmt replaces the contents of a materialized view.
+//
+//	REFRESH MATERIALIZED VIEW [ CONCURRENTLY ] <table name> [ WITH [ NO ] DATA ]
+//
+// It is rendered as a call of DBMS_MVIEW.REFRESH on Oracle.
+type RefreshMaterializedViewStmt struct {
+	Concurrent bool
+	Name       *TableName
+	Data       *bool
+}
+
+// RefreshMaterializedView replaces the contents of a materialized view.
+func RefreshMaterializedView[T ToTableName](name T) *RefreshMaterializedViewStmt {
+	return &RefreshMaterializedViewStmt{Name: newTableName(name)}
+}
+
+// Concurrently refreshes the materialized view without locking out concurrent selects on it.
+func (s *RefreshMaterializedViewStmt) Concurrently() *RefreshMaterializedViewStmt {
+	s.Concurrent = true
+	return s
+}
+
+// WithData populates the materialized view, it is the default.
+func (s *RefreshMaterializedViewStmt) WithData() *RefreshMaterializedViewStmt {
+	data := true
+	s.Data = &data
+	return s
+}
+
+// WithNoData frees the storage of the materialized view and leaves it unpopulated.
+func (s *RefreshMaterializedViewStmt) WithNoData() *RefreshMaterializedViewStmt {
+	data := false
+	s.Data = &data
+	return s
+}
+
+const (
+	kRefreshMaterializedView = Keyword("REFRESH MATERIALIZED VIEW")
+	kRefreshMView            = Keyword("DBMS_MVIEW.REFRESH")
+	kBegin                   = Keyword("BEGIN")
+	kEnd                     = Keyword("END")
+)
+
+func (s *RefreshMaterializedViewStmt) Accept(v Visitor) Visitor {
+	only(v, "REFRESH MATERIALIZED VIEW", PostgreSQL, Oracle)
+
+	if v.Dialect() == Oracle {
+		if s.Data != nil && !*s.Data {
+			v.Fail(fmt.Errorf("%w: REFRESH MATERIALIZED VIEW WITH NO DATA", ErrUnsupported))
+		}
+
+		return v.Visit(kBegin, WS, kRefreshMView, Paren(Raw(quoteLiteral(s.Name.String()))), Token(';'), WS, kEnd, Token(';'))
+	}
+
+	return v.Visit(kRefreshMaterializedView).
+		If(s.Concurrent, WS, kConcurrently).
+		Visit(WS, s.Name).
+		IfNotNil(s.Data, WS, AcceptFunc(func(v Visitor) Visitor {
+			return v.IfElse(*s.Data, kWithData, kWithNoData)
+		}))
+}
+
+func (s *RefreshMaterializedViewStmt) String() string { return XQL(s) }
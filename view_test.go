@@ -0,0 +1,73 @@
+package xql_test
+
+import (
+	"fmt"
+
+	. "github.com/flier/xql"
+)
+
+func ExampleCreateView() {
+	comedies := CreateView("comedies").OrReplace().
+		As(Select(Asterisk).From(QName("films")).Where(Eq(Column("kind"), "Comedy"))).
+		WithLocalCheckOption()
+
+	for _, d := range []Dialect{PostgreSQL, MySQL, SQLServer, Oracle} {
+		s, err := d.Build(comedies)
+		fmt.Printf("%s: %s %v\n", d, s, err)
+	}
+
+	fmt.Println(CreateView("universal_comedies").
+		As(Select(Asterisk).From(QName("comedies")).Where(Eq(Column("classification"), "U"))).
+		WithCascadedCheckOption())
+	fmt.Println(Oracle.XQL(CreateView("kinds", "kind").As(Select(Column("kind")).From(QName("films"))).WithReadOnly()))
+	fmt.Println(SQLite.XQL(CreateView("recent").Temporary().As(Select(Asterisk).From(QName("films")))))
+	// Output:
+	// PostgreSQL: CREATE OR REPLACE VIEW comedies AS SELECT * FROM films WHERE kind = 'Comedy' WITH LOCAL CHECK OPTION <nil>
+	// MySQL: CREATE OR REPLACE VIEW comedies AS SELECT * FROM films WHERE kind = 'Comedy' WITH LOCAL CHECK OPTION <nil>
+	// SQL Server:  xql: unsupported by the dialect: WITH LOCAL CHECK OPTION
+	// Oracle:  xql: unsupported by the dialect: WITH LOCAL CHECK OPTION
+	// CREATE VIEW universal_comedies AS SELECT * FROM comedies WHERE classification = 'U' WITH CASCADED CHECK OPTION
+	// CREATE VIEW kinds (kind) AS SELECT kind FROM films WITH READ ONLY
+	// CREATE TEMPORARY VIEW recent AS SELECT * FROM films
+}
+
+func ExampleViewDef_Recursive() {
+	nums := CreateView("nums_1_100", "n").Recursive().
+		As(Select(Raw("1")))
+
+	fmt.Println(PostgreSQL.XQL(nums))
+
+	_, err := MySQL.Build(nums)
+	fmt.Println(err)
+	_, err = PostgreSQL.Build(CreateView("nums").Recursive().As(Select(Raw("1"))))
+	fmt.Println(err)
+	_, err = Build(CreateView("nums", "n"))
+	fmt.Println(err)
+	// Output:
+	// CREATE RECURSIVE VIEW nums_1_100 (n) AS SELECT 1
+	// xql: unsupported by the dialect: CREATE RECURSIVE VIEW
+	// xql: incomplete statement: CREATE RECURSIVE VIEW without the columns
+	// xql: incomplete statement: CREATE VIEW without the query
+}
+
+func ExampleCreateMaterializedView() {
+	mv := CreateMaterializedView("sales_summary").
+		As(Select(Column("seller_no"), Column("invoice_date")).From(QName("invoice"))).
+		WithNoData()
+
+	fmt.Println(PostgreSQL.XQL(mv))
+	fmt.Println(Oracle.XQL(mv))
+	fmt.Println(PostgreSQL.XQL(RefreshMaterializedView("sales_summary").Concurrently()))
+	fmt.Println(Oracle.XQL(RefreshMaterializedView("sales_summary")))
+	fmt.Println(DropMaterializedView("sales_summary").IfExists())
+
+	_, err := MySQL.Build(mv)
+	fmt.Println(err)
+	// Output:
+	// CREATE MATERIALIZED VIEW sales_summary AS SELECT seller_no, invoice_date FROM invoice WITH NO DATA
+	// CREATE MATERIALIZED VIEW sales_summary BUILD DEFERRED AS SELECT seller_no, invoice_date FROM invoice
+	// REFRESH MATERIALIZED VIEW CONCURRENTLY sales_summary
+	// BEGIN DBMS_MVIEW.REFRESH('sales_summary'); END;
+	// DROP MATERIALIZED VIEW IF EXISTS sales_summary
+	// xql: unsupported by the dialect: CREATE MATERIALIZED VIEW
+}